@@ -0,0 +1,240 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+const (
+	attachmentFormField = "file"
+
+	// multipartOverhead 파일 외에 multipart 본문에 포함되는 경계 문자열과 헤더의 여유분입니다.
+	multipartOverhead = 64 << 10
+)
+
+// AttachmentResponse 첨부 파일의 메타데이터입니다. 본문은 다운로드 경로로 받습니다.
+type AttachmentResponse struct {
+	ID     string `json:"id" example:"01JZ3S"`
+	TaskID string `json:"task_id" example:"01JZ3Q"`
+	Name   string `json:"name" example:"screenshot.png"`
+	// ContentType 업로드된 본문의 앞부분으로 판별한 MIME 타입입니다.
+	ContentType string `json:"content_type" example:"image/png"`
+	Size        int64  `json:"size" example:"48213"`
+	// Checksum 본문의 SHA-256 해시(16진수)입니다.
+	Checksum  string    `json:"checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	CreatedAt time.Time `json:"created_at" example:"2026-01-02T03:04:05.123456Z"`
+	CreatedBy string    `json:"created_by" example:"alice"`
+}
+
+type AttachmentListResponse struct {
+	Attachments []*AttachmentResponse `json:"attachments"`
+}
+
+func newAttachmentResponse(attachment *domain.Attachment) *AttachmentResponse {
+	blob := attachment.Blob()
+
+	return &AttachmentResponse{
+		ID:          string(attachment.ID()),
+		TaskID:      string(attachment.TaskID()),
+		Name:        attachment.Name(),
+		ContentType: blob.ContentType,
+		Size:        blob.Size,
+		Checksum:    blob.Checksum,
+		CreatedAt:   attachment.Created().At(),
+		CreatedBy:   attachment.Created().By(),
+	}
+}
+
+// ListAttachments Task 첨부 파일 목록 조회
+// @Summary List attachments
+// @Description Task의 첨부 파일 메타데이터를 업로드 순서로 조회합니다
+// @Tags attachments
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} AttachmentListResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/attachments [get]
+func (h *Handler) ListAttachments(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	attachments, err := h.service.ListAttachments(ctx, domain.TaskID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ret := make([]*AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		ret[i] = newAttachmentResponse(attachment)
+	}
+
+	ctx.JSON(http.StatusOK, &AttachmentListResponse{Attachments: ret})
+}
+
+// CreateAttachment Task에 파일 첨부
+// @Summary Upload attachment
+// @Description multipart/form-data의 file 필드로 받은 파일을 Task에 첨부합니다. 최대 크기를 넘으면 413을 반환합니다
+// @Description content_type은 클라이언트가 보낸 값이 아니라 본문의 앞부분으로 판별합니다. 파일 이름은 1-255자이며 경로를 포함할 수 없습니다
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param file formData file true "File"
+// @Success 201 {object} AttachmentResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 413 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/attachments [post]
+func (h *Handler) CreateAttachment(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	// 파일 전체를 받기 전에 최대 크기를 넘는 요청을 끊습니다.
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, h.service.MaxAttachmentSize()+multipartOverhead)
+
+	header, err := ctx.FormFile(attachmentFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			respondError(ctx, fmt.Errorf("%w: %w", flow.ErrAttachmentTooLarge, err))
+
+			return
+		}
+
+		respondError(ctx, fmt.Errorf("%w: %w", errMissingFile, err))
+
+		return
+	}
+
+	spec, err := domain.NewAttachmentSpec(header.Filename)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		respondError(ctx, fmt.Errorf("failed to open uploaded file: %w", err))
+
+		return
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	attachment, err := h.service.CreateAttachment(ctx, domain.TaskID(id), spec, file, header.Size)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newAttachmentResponse(attachment))
+}
+
+// DownloadAttachment 첨부 파일 다운로드
+// @Summary Download attachment
+// @Description 첨부 파일의 본문을 업로드할 때 판별한 Content-Type과 원래 파일 이름의 Content-Disposition으로 반환합니다
+// @Tags attachments
+// @Produce octet-stream
+// @Param id path string true "Task ID"
+// @Param attachment_id path string true "Attachment ID"
+// @Success 200 {file} file
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/attachments/{attachment_id} [get]
+func (h *Handler) DownloadAttachment(ctx *gin.Context) {
+	id, attachmentID, err := attachmentParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	attachment, body, err := h.service.OpenAttachment(ctx, id, attachmentID)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	defer func() {
+		_ = body.Close()
+	}()
+
+	blob := attachment.Blob()
+
+	ctx.DataFromReader(http.StatusOK, blob.Size, blob.ContentType, body, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name()}),
+		// 브라우저가 본문을 다시 판별하여 HTML 등으로 실행하지 않도록 합니다.
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// DeleteAttachment 첨부 파일 삭제
+// @Summary Delete attachment
+// @Description 첨부 파일의 메타데이터와 본문을 삭제합니다
+// @Tags attachments
+// @Param id path string true "Task ID"
+// @Param attachment_id path string true "Attachment ID"
+// @Success 204
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/attachments/{attachment_id} [delete]
+func (h *Handler) DeleteAttachment(ctx *gin.Context) {
+	id, attachmentID, err := attachmentParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	err = h.service.DeleteAttachment(ctx, id, attachmentID)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// attachmentParams 경로의 Task ID와 첨부 파일 ID를 읽습니다.
+func attachmentParams(ctx *gin.Context) (domain.TaskID, domain.AttachmentID, error) {
+	id := ctx.Param("id")
+	if id == "" {
+		return "", "", errMissingTaskID
+	}
+
+	attachmentID := ctx.Param("attachment_id")
+	if attachmentID == "" {
+		return "", "", errMissingAttachmentID
+	}
+
+	return domain.TaskID(id), domain.AttachmentID(attachmentID), nil
+}
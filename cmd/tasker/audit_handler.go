@@ -0,0 +1,162 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+type AuditEntryResponse struct {
+	ID      string                 `json:"id" example:"01JZ3R"`
+	TaskID  string                 `json:"task_id" example:"01JZ3Q"`
+	Action  string                 `json:"action" example:"update" enums:"create,update,delete,restore,purge"`
+	Actor   string                 `json:"actor" example:"alice"`
+	At      time.Time              `json:"at" example:"2026-01-02T03:04:05.123456Z"`
+	Changes []*FieldChangeResponse `json:"changes"`
+}
+
+// FieldChangeResponse 필드 하나의 변경 전후 값입니다. 값이 없으면 빈 문자열이며, 태그는 쉼표로 구분된 이름입니다.
+type FieldChangeResponse struct {
+	Field  string `json:"field" example:"title"`
+	Before string `json:"before" example:"이전 제목"`
+	After  string `json:"after" example:"새 제목"`
+}
+
+type AuditListResponse struct {
+	Entries    []*AuditEntryResponse `json:"entries"`
+	NextCursor string                `json:"next_cursor,omitempty" example:"01JZ3R"`
+}
+
+func newAuditEntryResponse(entry *domain.AuditEntry) *AuditEntryResponse {
+	return &AuditEntryResponse{
+		ID:      string(entry.ID()),
+		TaskID:  string(entry.TaskID()),
+		Action:  string(entry.Action()),
+		Actor:   entry.Stamp().By(),
+		At:      entry.Stamp().At(),
+		Changes: newFieldChangeResponses(entry.Changes()),
+	}
+}
+
+func newFieldChangeResponses(changes []domain.FieldChange) []*FieldChangeResponse {
+	ret := make([]*FieldChangeResponse, len(changes))
+	for i, change := range changes {
+		ret[i] = &FieldChangeResponse{Field: change.Field(), Before: change.Before(), After: change.After()}
+	}
+
+	return ret
+}
+
+func newAuditListResponse(page *core.AuditPage) *AuditListResponse {
+	entries := make([]*AuditEntryResponse, len(page.Entries))
+	for i := range page.Entries {
+		entries[i] = newAuditEntryResponse(&page.Entries[i])
+	}
+
+	return &AuditListResponse{Entries: entries, NextCursor: string(page.NextCursor)}
+}
+
+// ListAuditEntries 감사 기록 조회
+// @Summary List audit entries
+// @Description 모든 Task의 변경 기록을 기록된 순서로 조회합니다. since는 포함하고 until은 포함하지 않습니다
+// @Description 동시에 저장된 기록은 이미 지나간 cursor보다 앞에 나타날 수 있으므로, cursor로 새 기록을 이어 받는
+// @Description 용도에는 빠짐없는 조회를 보장하지 않습니다. 새 기록을 놓치지 않으려면 since를 겹치게 지정하여 다시 조회하세요
+// @Tags audit
+// @Produce json
+// @Param task_id query string false "Filter by task ID"
+// @Param actor query string false "Filter by actor"
+// @Param since query string false "Only entries recorded at or after this RFC 3339 date-time"
+// @Param until query string false "Only entries recorded before this RFC 3339 date-time"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Success 200 {object} AuditListResponse
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /audit [get]
+func (h *Handler) ListAuditEntries(ctx *gin.Context) {
+	query, err := newAuditQuery(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	query.TaskID = domain.TaskID(ctx.Query("task_id"))
+	query.Actor = ctx.Query("actor")
+
+	page, err := h.service.ListAuditEntries(ctx, query)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAuditListResponse(page))
+}
+
+// TaskHistory Task 변경 기록 조회
+// @Summary Get task history
+// @Description Task의 생성부터 영구 삭제까지의 변경 기록을 기록된 순서로 조회합니다. 휴지통에 있거나 영구 삭제된 Task도 조회할 수 있습니다
+// @Tags audit
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param since query string false "Only entries recorded at or after this RFC 3339 date-time"
+// @Param until query string false "Only entries recorded before this RFC 3339 date-time"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Success 200 {object} AuditListResponse
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/history [get]
+func (h *Handler) TaskHistory(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	query, err := newAuditQuery(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	page, err := h.service.TaskHistory(ctx, domain.TaskID(id), query)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAuditListResponse(page))
+}
+
+// newAuditQuery 두 감사 기록 조회에 공통인 기간과 페이지 쿼리 파라미터를 읽습니다.
+func newAuditQuery(ctx *gin.Context) (*core.AuditQuery, error) {
+	var query core.AuditQuery
+
+	limit, err := queryLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Limit = limit
+	query.After = domain.AuditEntryID(ctx.Query("cursor"))
+
+	query.Since, err = queryTime(ctx, "since")
+	if err != nil {
+		return nil, err
+	}
+
+	query.Until, err = queryTime(ctx, "until")
+	if err != nil {
+		return nil, err
+	}
+
+	return &query, nil
+}
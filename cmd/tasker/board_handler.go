@@ -0,0 +1,176 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+type BoardColumnRequest struct {
+	// Key 열을 가리키는 키로, 소문자로 저장되며 Task를 옮길 때 column으로 보냅니다.
+	Key  string `json:"key" example:"doing"`
+	Name string `json:"name" example:"진행 중"`
+	// Statuses 열에 놓일 Task의 상태입니다. 다른 열에서 옮겨 온 Task는 이 중 전이할 수 있는 첫 번째 상태로 바뀝니다.
+	Statuses []string `json:"statuses" example:"in_progress,blocked"`
+}
+
+type SetProjectColumnsRequest struct {
+	Columns []BoardColumnRequest `json:"columns"`
+}
+
+type BoardColumnResponse struct {
+	Key      string   `json:"key" example:"doing"`
+	Name     string   `json:"name" example:"진행 중"`
+	Statuses []string `json:"statuses" example:"in_progress,blocked"`
+}
+
+type BoardLaneResponse struct {
+	BoardColumnResponse
+
+	// Tasks 열에 놓인 Task를 보드 순서대로 담습니다.
+	Tasks []*TaskResponse `json:"tasks"`
+}
+
+type BoardResponse struct {
+	ProjectID string               `json:"project_id" example:"01JZ3R"`
+	Columns   []*BoardLaneResponse `json:"columns"`
+}
+
+func newBoardColumnResponses(columns []domain.BoardColumn) []*BoardColumnResponse {
+	ret := make([]*BoardColumnResponse, len(columns))
+	for i, column := range columns {
+		ret[i] = newBoardColumnResponse(column)
+	}
+
+	return ret
+}
+
+func newBoardColumnResponse(column domain.BoardColumn) *BoardColumnResponse {
+	statuses := make([]string, len(column.Statuses()))
+	for i, status := range column.Statuses() {
+		statuses[i] = string(status)
+	}
+
+	return &BoardColumnResponse{Key: column.Key(), Name: column.Name(), Statuses: statuses}
+}
+
+// SetProjectColumns 프로젝트 보드의 열 설정
+// @Summary Set project board columns
+// @Description 프로젝트 보드의 열을 순서대로 설정합니다. 열은 1-20개이며, 키는 문자, 숫자, '-', '_'로 이루어진 1-30자이고
+// @Description 이름은 앞뒤 공백을 제외하고 1-50자입니다. 열마다 하나 이상의 상태를 지정하며, 키나 상태가 다른 열과 겹치면
+// @Description 422를 반환합니다. 어느 열에도 속하지 않는 상태의 Task는 보드에 보이지 않습니다
+// @Description If-Match 헤더가 현재 ETag와 다르면 412를, 동시 수정이 감지되면 409를 반환합니다
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Param columns body SetProjectColumnsRequest true "Board columns"
+// @Success 200 {object} ProjectResponse
+// @Header 200 {string} ETag "Project version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /projects/{id}/columns [put]
+func (h *Handler) SetProjectColumns(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingProjectID)
+
+		return
+	}
+
+	var req SetProjectColumnsRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	version, err := ifMatchVersion(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	columns, err := newBoardColumns(req.Columns)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	project, err := h.service.SetProjectColumns(ctx, domain.ProjectID(id), columns, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	respondProject(ctx, http.StatusOK, project)
+}
+
+// GetBoard 프로젝트 보드 조회
+// @Summary Get project board
+// @Description 프로젝트 보드의 열마다 놓인 Task를 보드 순서대로 조회합니다. 열을 설정하지 않은 프로젝트는 상태마다 열이 하나씩 있습니다
+// @Tags projects
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} BoardResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /projects/{id}/board [get]
+func (h *Handler) GetBoard(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingProjectID)
+
+		return
+	}
+
+	lanes, err := h.service.GetBoard(ctx, domain.ProjectID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	response := &BoardResponse{ProjectID: id, Columns: make([]*BoardLaneResponse, len(lanes))}
+
+	for index, lane := range lanes {
+		tasks, err := h.newTaskResponses(ctx, lane.Tasks)
+		if err != nil {
+			respondError(ctx, err)
+
+			return
+		}
+
+		response.Columns[index] = &BoardLaneResponse{
+			BoardColumnResponse: *newBoardColumnResponse(lane.Column),
+			Tasks:               tasks,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func newBoardColumns(req []BoardColumnRequest) ([]domain.BoardColumn, error) {
+	columns := make([]domain.BoardColumn, len(req))
+
+	for index, column := range req {
+		statuses := make([]domain.TaskStatus, len(column.Statuses))
+		for i, status := range column.Statuses {
+			statuses[i] = domain.TaskStatus(status)
+		}
+
+		columns[index] = domain.NewBoardColumn(column.Key, column.Name, statuses)
+	}
+
+	return domain.NewBoardColumns(columns) //nolint:wrapcheck
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+type ChecklistItemResponse struct {
+	ID   string `json:"id" example:"01JZ3T"`
+	Text string `json:"text" example:"테스트 작성"`
+	Done bool   `json:"done" example:"false"`
+}
+
+type ChecklistResponse struct {
+	Items    []*ChecklistItemResponse `json:"items"`
+	Progress *ProgressResponse        `json:"progress"`
+}
+
+type AddChecklistItemRequest struct {
+	Text string `json:"text" example:"테스트 작성"`
+}
+
+type ReorderChecklistRequest struct {
+	// ItemIDs 체크리스트의 모든 항목 ID를 원하는 순서로 한 번씩 담습니다.
+	ItemIDs []string `json:"item_ids" example:"01JZ3U,01JZ3T"`
+}
+
+func newChecklistItemResponses(items []domain.ChecklistItem) []*ChecklistItemResponse {
+	ret := make([]*ChecklistItemResponse, len(items))
+	for i, item := range items {
+		ret[i] = &ChecklistItemResponse{ID: string(item.ID()), Text: item.Text(), Done: item.Done()}
+	}
+
+	return ret
+}
+
+// GetChecklist Task 체크리스트 조회
+// @Summary Get checklist
+// @Description Task의 체크리스트 항목을 순서대로 조회하고 완료 비율을 함께 반환합니다
+// @Tags checklist
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} ChecklistResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/checklist [get]
+func (h *Handler) GetChecklist(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	task, err := h.service.GetTask(ctx, domain.TaskID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &ChecklistResponse{
+		Items:    newChecklistItemResponses(task.Checklist()),
+		Progress: newProgressResponse(task.ChecklistProgress()),
+	})
+}
+
+// AddChecklistItem 체크리스트 항목 추가
+// @Summary Add checklist item
+// @Description 체크리스트 끝에 완료되지 않은 항목을 추가합니다. 내용은 앞뒤 공백을 제외하고 1-500자의 한 줄이며,
+// @Description 항목은 Task마다 최대 100개입니다. 위반 시 422를 반환합니다
+// @Tags checklist
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Param item body AddChecklistItemRequest true "Checklist item"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/checklist [post]
+func (h *Handler) AddChecklistItem(ctx *gin.Context) {
+	id, version, err := taskVersionParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	var req AddChecklistItemRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.AddChecklistItem(ctx, id, req.Text, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// ReorderChecklist 체크리스트 순서 변경
+// @Summary Reorder checklist
+// @Description 체크리스트를 item_ids 순서로 재배열합니다. item_ids가 모든 항목을 한 번씩 담고 있지 않으면 422를 반환합니다
+// @Tags checklist
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Param order body ReorderChecklistRequest true "Checklist item IDs in the new order"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/checklist/order [put]
+func (h *Handler) ReorderChecklist(ctx *gin.Context) {
+	id, version, err := taskVersionParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	var req ReorderChecklistRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	itemIDs := make([]domain.ChecklistItemID, len(req.ItemIDs))
+	for i, itemID := range req.ItemIDs {
+		itemIDs[i] = domain.ChecklistItemID(itemID)
+	}
+
+	task, err := h.service.ReorderChecklist(ctx, id, itemIDs, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// CheckChecklistItem 체크리스트 항목 완료
+// @Summary Check checklist item
+// @Description 체크리스트 항목을 완료로 표시합니다. 이미 완료되었으면 Task를 수정하지 않고 그대로 반환합니다
+// @Tags checklist
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param item_id path string true "Checklist item ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/checklist/{item_id}/check [post]
+func (h *Handler) CheckChecklistItem(ctx *gin.Context) {
+	id, itemID, version, err := checklistItemParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.CheckChecklistItem(ctx, id, itemID, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// UncheckChecklistItem 체크리스트 항목 완료 해제
+// @Summary Uncheck checklist item
+// @Description 체크리스트 항목의 완료 표시를 해제합니다. 완료되지 않았으면 Task를 수정하지 않고 그대로 반환합니다
+// @Tags checklist
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param item_id path string true "Checklist item ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/checklist/{item_id}/uncheck [post]
+func (h *Handler) UncheckChecklistItem(ctx *gin.Context) {
+	id, itemID, version, err := checklistItemParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.UncheckChecklistItem(ctx, id, itemID, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// RemoveChecklistItem 체크리스트 항목 삭제
+// @Summary Remove checklist item
+// @Description 체크리스트에서 항목을 삭제합니다
+// @Tags checklist
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param item_id path string true "Checklist item ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/checklist/{item_id} [delete]
+func (h *Handler) RemoveChecklistItem(ctx *gin.Context) {
+	id, itemID, version, err := checklistItemParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.RemoveChecklistItem(ctx, id, itemID, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// taskVersionParams 경로의 Task ID와 If-Match 버전을 읽습니다.
+func taskVersionParams(ctx *gin.Context) (domain.TaskID, int, error) {
+	id := ctx.Param("id")
+	if id == "" {
+		return "", 0, errMissingTaskID
+	}
+
+	version, err := ifMatchVersion(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return domain.TaskID(id), version, nil
+}
+
+// checklistItemParams 경로의 Task ID와 체크리스트 항목 ID, If-Match 버전을 읽습니다.
+func checklistItemParams(ctx *gin.Context) (domain.TaskID, domain.ChecklistItemID, int, error) {
+	itemID := ctx.Param("item_id")
+	if itemID == "" {
+		return "", "", 0, errMissingChecklistItemID
+	}
+
+	id, version, err := taskVersionParams(ctx)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	return id, domain.ChecklistItemID(itemID), version, nil
+}
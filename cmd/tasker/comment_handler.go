@@ -0,0 +1,230 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+type CreateCommentRequest struct {
+	Body string `json:"body" example:"**리뷰** 부탁드립니다"`
+	// ParentID 비어 있지 않으면 같은 Task의 해당 댓글에 답글을 답니다.
+	ParentID string `json:"parent_id" example:"01JZ3R"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" example:"수정된 본문"`
+}
+
+// CommentResponse 댓글과 그 아래에 달린 답글입니다. 본문은 Markdown 원문입니다.
+type CommentResponse struct {
+	ID        string             `json:"id" example:"01JZ3R"`
+	TaskID    string             `json:"task_id" example:"01JZ3Q"`
+	ParentID  string             `json:"parent_id,omitempty" example:"01JZ3R"`
+	Author    string             `json:"author" example:"alice"`
+	Body      string             `json:"body" example:"**리뷰** 부탁드립니다"`
+	Edited    bool               `json:"edited" example:"false"`
+	CreatedAt time.Time          `json:"created_at" example:"2026-01-02T03:04:05.123456Z"`
+	UpdatedAt time.Time          `json:"updated_at" example:"2026-01-02T03:04:05.123456Z"`
+	Replies   []*CommentResponse `json:"replies"`
+}
+
+type CommentListResponse struct {
+	Comments []*CommentResponse `json:"comments"`
+}
+
+func newCommentResponse(comment *domain.Comment) *CommentResponse {
+	return &CommentResponse{
+		ID:        string(comment.ID()),
+		TaskID:    string(comment.TaskID()),
+		ParentID:  string(comment.ParentID()),
+		Author:    comment.Author(),
+		Body:      comment.Body(),
+		Edited:    comment.IsEdited(),
+		CreatedAt: comment.Created().At(),
+		UpdatedAt: comment.Updated().At(),
+		Replies:   []*CommentResponse{},
+	}
+}
+
+func newCommentThreadResponses(threads []*domain.CommentThread) []*CommentResponse {
+	ret := make([]*CommentResponse, len(threads))
+	for i, thread := range threads {
+		ret[i] = newCommentResponse(thread.Comment())
+		ret[i].Replies = newCommentThreadResponses(thread.Replies())
+	}
+
+	return ret
+}
+
+// ListComments Task 댓글 목록 조회
+// @Summary List comments
+// @Description Task의 댓글을 작성 순서로 조회합니다. 답글은 replies에 같은 형식으로 담깁니다
+// @Tags comments
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} CommentListResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/comments [get]
+func (h *Handler) ListComments(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	threads, err := h.service.ListComments(ctx, domain.TaskID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &CommentListResponse{Comments: newCommentThreadResponses(threads)})
+}
+
+// CreateComment Task에 댓글 작성
+// @Summary Create comment
+// @Description Task에 Markdown 댓글을 작성합니다. 본문은 앞뒤 공백을 제외하고 1-10000자이며 위반 시 422를 반환합니다
+// @Description parent_id를 지정하면 해당 댓글에 답글을 달며, 같은 Task에 그 댓글이 없으면 422를 반환합니다
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param comment body CreateCommentRequest true "Comment"
+// @Success 201 {object} CommentResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/comments [post]
+func (h *Handler) CreateComment(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	spec, err := domain.NewCommentSpec(req.Body)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	comment, err := h.service.CreateComment(ctx, domain.TaskID(id), domain.CommentID(req.ParentID), spec)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newCommentResponse(comment))
+}
+
+// UpdateComment 댓글 수정
+// @Summary Update comment
+// @Description 댓글의 본문을 수정합니다. 작성자만 수정할 수 있으며, 작성자가 아니거나 X-Actor 헤더가 없으면 403을 반환합니다
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param comment_id path string true "Comment ID"
+// @Param comment body UpdateCommentRequest true "Updated comment"
+// @Success 200 {object} CommentResponse
+// @Failure 400 {object} Problem
+// @Failure 403 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/comments/{comment_id} [put]
+func (h *Handler) UpdateComment(ctx *gin.Context) {
+	id, commentID, err := commentParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	var req UpdateCommentRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	spec, err := domain.NewCommentSpec(req.Body)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	comment, err := h.service.UpdateComment(ctx, id, commentID, spec)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCommentResponse(comment))
+}
+
+// DeleteComment 댓글 삭제
+// @Summary Delete comment
+// @Description 댓글과 그 아래의 모든 답글을 삭제합니다. 다른 사람이 작성한 답글도 함께 삭제됩니다.
+// @Description 작성자만 삭제할 수 있으며, 작성자가 아니거나 X-Actor 헤더가 없으면 403을 반환합니다
+// @Tags comments
+// @Param id path string true "Task ID"
+// @Param comment_id path string true "Comment ID"
+// @Success 204
+// @Failure 400 {object} Problem
+// @Failure 403 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/comments/{comment_id} [delete]
+func (h *Handler) DeleteComment(ctx *gin.Context) {
+	id, commentID, err := commentParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	err = h.service.DeleteComment(ctx, id, commentID)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// commentParams 경로의 Task ID와 댓글 ID를 읽습니다.
+func commentParams(ctx *gin.Context) (domain.TaskID, domain.CommentID, error) {
+	id := ctx.Param("id")
+	if id == "" {
+		return "", "", errMissingTaskID
+	}
+
+	commentID := ctx.Param("comment_id")
+	if commentID == "" {
+		return "", "", errMissingCommentID
+	}
+
+	return domain.TaskID(id), domain.CommentID(commentID), nil
+}
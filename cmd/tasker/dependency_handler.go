@@ -0,0 +1,170 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+// ListDependencies 선행 Task 목록 조회
+// @Summary List dependencies
+// @Description Task를 막고 있는(blocked by) 선행 Task 목록을 ID 순서로 조회합니다
+// @Tags dependencies
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} TaskListResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/dependencies [get]
+func (h *Handler) ListDependencies(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	tasks, err := h.service.ListDependencies(ctx, domain.TaskID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTasks(ctx, tasks)
+}
+
+// ListDependents 후행 Task 목록 조회
+// @Summary List dependents
+// @Description Task에 의해 막혀 있는 후행 Task 목록을 ID 순서로 조회합니다
+// @Tags dependencies
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} TaskListResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/dependents [get]
+func (h *Handler) ListDependents(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	tasks, err := h.service.ListDependents(ctx, domain.TaskID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTasks(ctx, tasks)
+}
+
+// AddDependency 의존성 추가
+// @Summary Add dependency
+// @Description Task가 dependency_id인 Task에 의해 막히도록 의존성을 추가합니다. 이미 있으면 아무 일도 하지 않습니다
+// @Description 선행 Task가 없으면 422를, 의존성에 순환이 생기면 409를 반환합니다. Task의 버전은 바뀌지 않습니다
+// @Tags dependencies
+// @Param id path string true "Task ID"
+// @Param dependency_id path string true "ID of the task that blocks this task"
+// @Success 204
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/dependencies/{dependency_id} [put]
+func (h *Handler) AddDependency(ctx *gin.Context) {
+	id, dependsOn, err := dependencyParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	err = h.service.AddDependency(ctx, id, dependsOn)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// RemoveDependency 의존성 삭제
+// @Summary Remove dependency
+// @Description Task와 dependency_id인 Task 사이의 의존성을 삭제합니다
+// @Tags dependencies
+// @Param id path string true "Task ID"
+// @Param dependency_id path string true "ID of the task that blocks this task"
+// @Success 204
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/dependencies/{dependency_id} [delete]
+func (h *Handler) RemoveDependency(ctx *gin.Context) {
+	id, dependsOn, err := dependencyParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	err = h.service.RemoveDependency(ctx, id, dependsOn)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// ExecutionOrder 실행 순서 조회
+// @Summary Order tasks by dependencies
+// @Description 주어진 Task를 선행 Task가 항상 먼저 오도록 정렬합니다(위상 정렬). 순서가 정해지지 않는 Task끼리는 ID 순서입니다
+// @Description 주어진 Task 밖의 의존성은 고려하지 않으며, 없는 Task가 있으면 404를 반환합니다
+// @Tags dependencies
+// @Produce json
+// @Param id query []string true "Task IDs (1-100)" collectionFormat(multi)
+// @Success 200 {object} TaskListResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/execution-order [get]
+func (h *Handler) ExecutionOrder(ctx *gin.Context) {
+	var ids []domain.TaskID
+
+	for _, value := range ctx.QueryArray("id") {
+		if value != "" {
+			ids = append(ids, domain.TaskID(value))
+		}
+	}
+
+	tasks, err := h.service.ExecutionOrder(ctx, ids)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTasks(ctx, tasks)
+}
+
+// dependencyParams 경로의 Task ID와 선행 Task ID를 읽습니다.
+func dependencyParams(ctx *gin.Context) (domain.TaskID, domain.TaskID, error) {
+	id := ctx.Param("id")
+	dependsOn := ctx.Param("dependency_id")
+
+	if id == "" || dependsOn == "" {
+		return "", "", errMissingTaskID
+	}
+
+	return domain.TaskID(id), domain.TaskID(dependsOn), nil
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/i18n"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+var (
+	errMissingTaskID          = errors.New("task ID is required")
+	errMissingTagID           = errors.New("tag ID is required")
+	errMissingCommentID       = errors.New("comment ID is required")
+	errMissingAttachmentID    = errors.New("attachment ID is required")
+	errMissingChecklistItemID = errors.New("checklist item ID is required")
+	errMissingProjectID       = errors.New("project ID is required")
+	errMissingFile            = errors.New("multipart file field is required")
+	errInvalidRequest         = errors.New("invalid request")
+	errInvalidRevision        = errors.New("revision must be a positive integer")
+	errRouteNotFound          = errors.New("route not found")
+	errInternal               = errors.New("internal server error")
+
+	errValidationFailed = errors.New("request body failed validation")
+	errMalformedJSON    = errors.New("malformed JSON")
+	errInvalidFieldType = errors.New("invalid field type")
+	errInvalidPatch     = errors.New("invalid patch document")
+
+	errUnsupportedMediaType = errors.New("unsupported media type")
+	errPatchTestFailed      = errors.New("patch test operation failed")
+)
+
+// 클라이언트가 분기할 수 있도록 변경하지 않는 에러 코드입니다.
+const (
+	codeMissingID             = "missing_id"
+	codeInvalidRequest        = "invalid_request"
+	codeValidationFailed      = "validation_failed"
+	codeInvalidStatus         = "invalid_status"
+	codeInvalidAction         = "invalid_action"
+	codeInvalidPriority       = "invalid_priority"
+	codeInvalidQuery          = "invalid_query"
+	codeInvalidCursor         = "invalid_cursor"
+	codeRouteNotFound         = "route_not_found"
+	codeTaskNotFound          = "task_not_found"
+	codeParentNotFound        = "parent_not_found"
+	codeParentCycle           = "parent_cycle"
+	codeDependencyTask        = "dependency_task_not_found"
+	codeDependencyNotFound    = "dependency_not_found"
+	codeDependencyCycle       = "dependency_cycle"
+	codeInvalidRevision       = "invalid_revision"
+	codeRevisionNotFound      = "revision_not_found"
+	codeParentComment         = "parent_comment_not_found"
+	codeCommentNotFound       = "comment_not_found"
+	codeCommentForbidden      = "comment_forbidden"
+	codeAttachmentNotFound    = "attachment_not_found"
+	codeAttachmentTooLarge    = "attachment_too_large"
+	codeChecklistItemNotFound = "checklist_item_not_found"
+	codeProjectNotFound       = "project_not_found"
+	codeTaskProject           = "task_project_not_found"
+	codeDuplicateProject      = "duplicate_project"
+	codeProjectNotEmpty       = "project_not_empty"
+	codeProjectConflict       = "project_conflict"
+	codeTaskNotOnBoard        = "task_not_on_board"
+	codeColumnNotFound        = "column_not_found"
+	codePositionTask          = "position_task_not_found"
+	codeTagNotFound           = "tag_not_found"
+	codeDuplicateTag          = "duplicate_tag"
+	codeConflict              = "conflict"
+	codeInvalidTransition     = "invalid_transition"
+	codePreconditionFailed    = "precondition_failed"
+	codePatchTestFailed       = "patch_test_failed"
+	codeUnsupportedMedia      = "unsupported_media_type"
+	codeTimeout               = "timeout"
+	codeRequestCancelled      = "request_cancelled"
+	codeReservedActor         = "reserved_actor"
+	codeInternal              = "internal_error"
+)
+
+// statusClientClosedRequest 클라이언트가 응답을 받기 전에 요청을 취소했음을 나타내는 nginx의 비표준 상태 코드입니다.
+// 클라이언트는 응답을 받지 못하지만 접근 로그에서 서버 에러와 구별됩니다.
+const statusClientClosedRequest = 499
+
+type errorKind struct {
+	target error
+	status int
+	code   string
+}
+
+// classifyError 에러를 HTTP 상태 코드와 에러 코드로 분류합니다.
+// 모든 핸들러는 이 매핑을 통해 에러 응답을 결정합니다.
+func classifyError(err error) errorKind {
+	kinds := []errorKind{
+		{errMissingTaskID, http.StatusBadRequest, codeMissingID},
+		{errMissingTagID, http.StatusBadRequest, codeMissingID},
+		{errMissingCommentID, http.StatusBadRequest, codeMissingID},
+		{errMissingAttachmentID, http.StatusBadRequest, codeMissingID},
+		{errMissingChecklistItemID, http.StatusBadRequest, codeMissingID},
+		{errMissingProjectID, http.StatusBadRequest, codeMissingID},
+		{errMissingFile, http.StatusBadRequest, codeInvalidRequest},
+		{errInvalidRequest, http.StatusBadRequest, codeInvalidRequest},
+		{domain.ErrValidation, http.StatusUnprocessableEntity, codeValidationFailed},
+		{domain.ErrInvalidTaskStatus, http.StatusBadRequest, codeInvalidStatus},
+		{domain.ErrInvalidTaskAction, http.StatusBadRequest, codeInvalidAction},
+		{domain.ErrInvalidTaskPriority, http.StatusBadRequest, codeInvalidPriority},
+		{core.ErrInvalidQuery, http.StatusBadRequest, codeInvalidQuery},
+		{core.ErrInvalidCursor, http.StatusBadRequest, codeInvalidCursor},
+		{errRouteNotFound, http.StatusNotFound, codeRouteNotFound},
+		{flow.ErrParentNotFound, http.StatusUnprocessableEntity, codeParentNotFound},
+		{flow.ErrParentCycle, http.StatusConflict, codeParentCycle},
+		{flow.ErrDependencyTaskNotFound, http.StatusUnprocessableEntity, codeDependencyTask},
+		{core.ErrDependencyNotFound, http.StatusNotFound, codeDependencyNotFound},
+		{domain.ErrDependencyCycle, http.StatusConflict, codeDependencyCycle},
+		{errInvalidRevision, http.StatusBadRequest, codeInvalidRevision},
+		{core.ErrRevisionNotFound, http.StatusNotFound, codeRevisionNotFound},
+		{flow.ErrParentCommentNotFound, http.StatusUnprocessableEntity, codeParentComment},
+		{core.ErrCommentNotFound, http.StatusNotFound, codeCommentNotFound},
+		{flow.ErrCommentForbidden, http.StatusForbidden, codeCommentForbidden},
+		{core.ErrAttachmentNotFound, http.StatusNotFound, codeAttachmentNotFound},
+		{flow.ErrAttachmentTooLarge, http.StatusRequestEntityTooLarge, codeAttachmentTooLarge},
+		{domain.ErrChecklistItemNotFound, http.StatusNotFound, codeChecklistItemNotFound},
+		{flow.ErrTaskProjectNotFound, http.StatusUnprocessableEntity, codeTaskProject},
+		{core.ErrProjectNotFound, http.StatusNotFound, codeProjectNotFound},
+		{core.ErrDuplicateProject, http.StatusConflict, codeDuplicateProject},
+		{core.ErrProjectNotEmpty, http.StatusConflict, codeProjectNotEmpty},
+		{core.ErrProjectConflict, http.StatusConflict, codeProjectConflict},
+		{flow.ErrTaskNotOnBoard, http.StatusConflict, codeTaskNotOnBoard},
+		{flow.ErrColumnNotFound, http.StatusUnprocessableEntity, codeColumnNotFound},
+		{flow.ErrPositionTaskNotFound, http.StatusUnprocessableEntity, codePositionTask},
+		{core.ErrTaskNotFound, http.StatusNotFound, codeTaskNotFound},
+		{core.ErrTagNotFound, http.StatusNotFound, codeTagNotFound},
+		{core.ErrDuplicateTag, http.StatusConflict, codeDuplicateTag},
+		{core.ErrConflict, http.StatusConflict, codeConflict},
+		{domain.ErrInvalidTransition, http.StatusConflict, codeInvalidTransition},
+		{errPatchTestFailed, http.StatusConflict, codePatchTestFailed},
+		{errUnsupportedMediaType, http.StatusUnsupportedMediaType, codeUnsupportedMedia},
+		{flow.ErrReservedActor, http.StatusBadRequest, codeReservedActor},
+		{flow.ErrPreconditionFailed, http.StatusPreconditionFailed, codePreconditionFailed},
+		{context.DeadlineExceeded, http.StatusGatewayTimeout, codeTimeout},
+		{context.Canceled, statusClientClosedRequest, codeRequestCancelled},
+	}
+
+	for _, kind := range kinds {
+		if errors.Is(err, kind.target) {
+			return kind
+		}
+	}
+
+	return errorKind{errInternal, http.StatusInternalServerError, codeInternal}
+}
+
+// respondError 에러를 요청 언어로 지역화된 application/problem+json 응답으로 작성합니다.
+// 에러 원문은 로그에만 남기고, 응답에는 클라이언트 에러의 구체적인 원인만 detail에 덧붙입니다.
+func respondError(ctx *gin.Context, err error) {
+	_ = ctx.Error(err)
+
+	kind := classifyError(err)
+	localizer := localizerFrom(ctx)
+
+	problem := Problem{
+		Type:      problemTypePrefix + kind.code,
+		Title:     localizer.Message("problem." + kind.code + ".title"),
+		Status:    kind.status,
+		Detail:    problemDetail(localizer, kind, err),
+		Instance:  ctx.Request.URL.Path,
+		Code:      kind.code,
+		RequestID: ctx.GetString(requestIDKey),
+		Errors:    nil,
+	}
+
+	for _, field := range fieldErrors(err) {
+		field.Message = validationMessage(localizer, field)
+		problem.Errors = append(problem.Errors, field)
+	}
+
+	ctx.Header("Content-Type", problemContentType)
+	ctx.AbortWithStatusJSON(kind.status, problem)
+}
+
+func problemDetail(localizer *i18n.Localizer, kind errorKind, err error) string {
+	var transitionErr *domain.TransitionError
+	if errors.As(err, &transitionErr) {
+		return localizer.Message("problem."+kind.code+".detail", transitionErr.Action, transitionErr.From)
+	}
+
+	if errors.Is(err, errMalformedJSON) {
+		return localizer.Message("problem." + kind.code + ".malformed")
+	}
+
+	if errors.Is(err, errInvalidPatch) {
+		return localizer.Message("problem." + kind.code + ".patch")
+	}
+
+	if errors.Is(err, errMissingFile) {
+		return localizer.Message("problem." + kind.code + ".file")
+	}
+
+	detail := localizer.Message("problem." + kind.code + ".detail")
+	if cause := problemCause(kind, err); cause != "" {
+		return localizer.Message("problem.cause", detail, cause)
+	}
+
+	return detail
+}
+
+// problemCause err에서 kind의 sentinel 에러 뒤에 덧붙은 구체적인 원인을 꺼냅니다. 예를 들어 "invalid query: unknown
+// sort field"의 원인은 "unknown sort field"입니다. 서버 에러의 원문은 노출하지 않으므로 클라이언트 에러만 원인을 반환하며,
+// 필드별 에러가 있으면 errors에 지역화된 메시지가 담기므로 원인을 덧붙이지 않습니다.
+func problemCause(kind errorKind, err error) string {
+	if kind.status >= http.StatusInternalServerError || fieldErrors(err) != nil {
+		return ""
+	}
+
+	_, cause, _ := strings.Cut(err.Error(), kind.target.Error()+": ")
+
+	return cause
+}
+
+// fieldErrors 요청 해석 에러와 도메인 검증 에러에서 필드별 에러를 추출합니다.
+func fieldErrors(err error) []FieldError {
+	var reqErr *requestError
+	if errors.As(err, &reqErr) {
+		return reqErr.fields
+	}
+
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		fields := make([]FieldError, len(validationErr.Violations))
+		for i, violation := range validationErr.Violations {
+			fields[i] = newFieldError(violation.Field, violation.Code, violation.Param)
+		}
+
+		return fields
+	}
+
+	return nil
+}
+
+func validationMessage(localizer *i18n.Localizer, field FieldError) string {
+	key := "validation." + field.Code
+	if !localizer.Has(key) {
+		return localizer.Message("validation.default", field.Field, field.Code)
+	}
+
+	return localizer.Message(key, field.Field, field.param)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+// setETag Task 버전을 strong ETag로 응답 헤더에 설정합니다.
+func setETag(ctx *gin.Context, task *domain.Task) {
+	ctx.Header("ETag", strconv.Quote(strconv.Itoa(task.Version())))
+}
+
+// setProjectETag 프로젝트 버전을 strong ETag로 응답 헤더에 설정합니다.
+func setProjectETag(ctx *gin.Context, project *domain.Project) {
+	ctx.Header("ETag", strconv.Quote(strconv.Itoa(project.Version())))
+}
+
+// ifMatchVersion If-Match 헤더에서 기대하는 Task나 프로젝트의 버전을 읽습니다. 헤더가 없거나 "*"이면 0을 반환합니다.
+func ifMatchVersion(ctx *gin.Context) (int, error) {
+	value := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+
+	// If-Match는 strong 비교를 사용하므로 weak ETag(W/"...")는 일치하지 않습니다.
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: malformed If-Match header %q", flow.ErrPreconditionFailed, value)
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("%w: unknown entity tag %q", flow.ErrPreconditionFailed, value)
+	}
+
+	return version, nil
+}
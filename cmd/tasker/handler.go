@@ -1,10 +1,13 @@
 package main
 
 import (
-	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/neatflowcv/tasker/internal/app/flow"
 	"github.com/neatflowcv/tasker/internal/pkg/domain"
 	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
@@ -19,197 +22,679 @@ func NewHandler(service *flow.Service) *Handler {
 }
 
 type CreateTaskRequest struct {
-	Title       string `json:"title" binding:"required" example:"새로운 작업"`
-	Description string `json:"description" example:"작업 설명"`
+	Title       string     `json:"title" example:"새로운 작업"`
+	Description string     `json:"description" example:"작업 설명"`
+	Priority    string     `json:"priority" example:"high" enums:"low,medium,high,urgent"`
+	DueDate     *time.Time `json:"due_date" example:"2026-01-31T18:00:00+09:00"`
+	ParentID    string     `json:"parent_id" example:"01JZ3Q"`
+	// ProjectID 생성할 때만 지정할 수 있으며, 전체 수정에서는 무시됩니다.
+	ProjectID string `json:"project_id" example:"01JZ3R"`
+}
+
+type TransitionTaskRequest struct {
+	Action string `json:"action" binding:"required" example:"start" enums:"start,block,unblock,complete,cancel,reopen"`
 }
 
 type TaskResponse struct {
-	ID          string `json:"id" example:"1"`
-	Title       string `json:"title" example:"새로운 작업"`
-	Description string `json:"description" example:"작업 설명"`
+	ID          string     `json:"id" example:"1"`
+	Title       string     `json:"title" example:"새로운 작업"`
+	Description string     `json:"description" example:"작업 설명"`
+	Status      string     `json:"status" example:"open"`
+	Priority    string     `json:"priority" example:"high"`
+	DueDate     *time.Time `json:"due_date" example:"2026-01-31T09:00:00Z"`
+	ParentID    string     `json:"parent_id,omitempty" example:"01JZ3Q"`
+	ProjectID   string     `json:"project_id,omitempty" example:"01JZ3R"`
+	// Key 프로젝트에 속한 Task에서만 채워지는 프로젝트 키와 번호입니다.
+	Key string `json:"key,omitempty" example:"OPS-42"`
+	// Rank 보드 열 안에서의 순서로, 사전 순으로 비교합니다. 보드에서 옮기면 바뀝니다.
+	Rank    string         `json:"rank" example:"01JZ3QI"`
+	Tags    []*TagResponse `json:"tags"`
+	Version int            `json:"version" example:"1"`
+
+	// Blocked 끝나지 않은(완료나 취소가 아닌) 선행 Task가 하나라도 있으면 true입니다. 상태의 blocked와는 별개입니다.
+	Blocked bool `json:"blocked" example:"false"`
+	// Progress 단건 조회에서만 채워지는 하위 Task 전체의 완료 현황입니다.
+	Progress *ProgressResponse `json:"progress,omitempty"`
+	// Checklist 체크리스트 항목을 순서대로 담습니다.
+	Checklist []*ChecklistItemResponse `json:"checklist"`
+	// ChecklistProgress 체크리스트의 완료 현황입니다. 항목이 없으면 모두 0입니다.
+	ChecklistProgress *ProgressResponse `json:"checklist_progress"`
+
+	CreatedAt time.Time `json:"created_at" example:"2026-01-02T03:04:05.123456Z"`
+	CreatedBy string    `json:"created_by" example:"alice"`
+	UpdatedAt time.Time `json:"updated_at" example:"2026-01-02T03:04:05.123456Z"`
+	UpdatedBy string    `json:"updated_by" example:"alice"`
+	// DeletedAt 휴지통에 있는 Task에서만 채워집니다.
+	DeletedAt *time.Time `json:"deleted_at,omitempty" example:"2026-01-03T03:04:05.123456Z"`
+}
+
+type TaskListResponse struct {
+	Tasks      []*TaskResponse `json:"tasks"`
+	NextCursor string          `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoiMDFKWjNRIiwiaWQiOiIwMUpaM1EifQ"`
+}
+
+func newTaskResponse(task *domain.Task) *TaskResponse {
+	return &TaskResponse{
+		ID:                string(task.ID()),
+		Title:             task.Title(),
+		Description:       task.Description(),
+		Status:            string(task.Status()),
+		Priority:          string(task.Priority()),
+		DueDate:           task.DueDate(),
+		ParentID:          string(task.ParentID()),
+		ProjectID:         string(task.ProjectID()),
+		Key:               task.Key(),
+		Rank:              task.Rank(),
+		Tags:              newTagResponses(task.Tags()),
+		Version:           task.Version(),
+		Blocked:           false,
+		Progress:          nil,
+		Checklist:         newChecklistItemResponses(task.Checklist()),
+		ChecklistProgress: newProgressResponse(task.ChecklistProgress()),
+		CreatedAt:         task.Created().At(),
+		CreatedBy:         task.Created().By(),
+		UpdatedAt:         task.Updated().At(),
+		UpdatedBy:         task.Updated().By(),
+		DeletedAt:         task.DeletedAt(),
+	}
 }
 
 // CreateTask 새로운 Task 생성
 // @Summary Create a new task
-// @Description 새로운 Task를 생성합니다
+// @Description 새로운 Task를 생성합니다. 제목은 앞뒤 공백을 제외하고 1-200자, 설명은 10000자 이하이며 위반 시 422를 반환합니다
+// @Description 새 Task는 open 상태로 생성되며, 상태는 전이(/tasks/{id}/transitions)로만 바꿀 수 있습니다
+// @Description parent_id를 지정하면 해당 Task의 하위 Task로 생성하며, 상위 Task가 없으면 422를 반환합니다
+// @Description project_id를 지정하면 프로젝트에 속한 Task로 생성하여 OPS-42와 같은 키를 붙이고, 우선순위를 지정하지 않으면
+// @Description 프로젝트의 기본 우선순위를 사용합니다. 프로젝트가 없으면 422를 반환합니다
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Param task body CreateTaskRequest true "Task information"
 // @Success 201 {object} TaskResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Header 201 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
 // @Router /tasks [post]
 func (h *Handler) CreateTask(ctx *gin.Context) {
 	var req CreateTaskRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
 
 		return
 	}
 
-	spec := domain.NewTaskSpec(req.Title, req.Description)
-
-	task, err := h.service.CreateTask(ctx, spec)
+	spec, err := newTaskSpec(&req)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 
 		return
 	}
 
-	response := TaskResponse{
-		ID:          string(task.ID()),
-		Title:       task.Title(),
-		Description: task.Description(),
+	task, err := h.service.CreateTask(ctx, spec)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
 	}
 
-	ctx.JSON(http.StatusCreated, response)
+	h.respondTask(ctx, http.StatusCreated, task)
 }
 
-// ListTasks 모든 Task 목록 조회
+// ListTasks Task 목록 조회
 // @Summary List tasks
-// @Description 모든 Task 목록을 조회합니다
+// @Description Task 목록을 커서 기반 페이지네이션으로 조회합니다
+// @Description 정렬 필드는 id, title, status, priority, due_date, created_at, updated_at이며 마감일이 없는 Task는 오름차순에서 뒤에 옵니다
+// @Description tag를 여러 번 지정하면 tag_match가 any일 때 하나라도, all일 때 모두 붙은 Task를 조회합니다
 // @Tags tasks
 // @Produce json
-// @Success 200 {array} TaskResponse
-// @Failure 500 {object} map[string]string
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort field, '-' prefix for descending"
+// @Param status query []string false "Filter by status" collectionFormat(multi)
+// @Param priority query []string false "Filter by priority" collectionFormat(multi)
+// @Param tag query []string false "Filter by tag name" collectionFormat(multi)
+// @Param tag_match query string false "Match any (default) or all of the given tags" Enums(any, all)
+// @Param q query string false "Filter by title substring (case-insensitive)"
+// @Param due_before query string false "Only tasks due before this RFC 3339 date-time"
+// @Param due_after query string false "Only tasks due at or after this RFC 3339 date-time"
+// @Success 200 {object} TaskListResponse
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
 // @Router /tasks [get]
 func (h *Handler) ListTasks(ctx *gin.Context) {
-	tasks, err := h.service.ListTasks(ctx)
+	query, err := newListTasksQuery(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	page, err := h.service.ListTasks(ctx, query)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTaskPage(ctx, page)
+}
+
+// ListOverdueTasks 마감일이 지난 Task 목록 조회
+// @Summary List overdue tasks
+// @Description 마감일이 지났지만 완료되거나 취소되지 않은 Task 목록을 조회합니다. 기본 정렬은 마감일 오름차순입니다
+// @Tags tasks
+// @Produce json
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort field (default due_date), '-' prefix for descending"
+// @Param status query []string false "Filter by status" collectionFormat(multi)
+// @Param priority query []string false "Filter by priority" collectionFormat(multi)
+// @Param tag query []string false "Filter by tag name" collectionFormat(multi)
+// @Param tag_match query string false "Match any (default) or all of the given tags" Enums(any, all)
+// @Param q query string false "Filter by title substring (case-insensitive)"
+// @Success 200 {object} TaskListResponse
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/overdue [get]
+func (h *Handler) ListOverdueTasks(ctx *gin.Context) {
+	query, err := newListTasksQuery(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 
 		return
 	}
 
-	var responses []*TaskResponse
-	for _, task := range tasks {
-		responses = append(responses, &TaskResponse{
-			ID:          string(task.ID()),
-			Title:       task.Title(),
-			Description: task.Description(),
-		})
+	if ctx.Query("sort") == "" {
+		query.Sort = core.TaskSort{Field: core.TaskSortDueDate, Descending: false}
 	}
 
-	ctx.JSON(http.StatusOK, responses)
+	page, err := h.service.ListOverdueTasks(ctx, query)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTaskPage(ctx, page)
+}
+
+// newTaskSpec 생성·전체 수정 요청을 검증된 TaskSpec으로 변환합니다. 상태는 전이로만 바뀌므로 요청에 없으며,
+// 새 Task는 항상 open 상태로 생성됩니다.
+// 우선순위를 지정하지 않으면 생성할 때는 프로젝트의 기본값이, 수정할 때는 저장된 우선순위가 유지되도록
+// 빈 값으로 전달합니다.
+func newTaskSpec(req *CreateTaskRequest) (*domain.TaskSpec, error) {
+	var priority domain.TaskPriority
+
+	if req.Priority != "" {
+		parsed, err := domain.ParseTaskPriority(req.Priority)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		priority = parsed
+	}
+
+	return domain.NewTaskSpec(domain.TaskSpecParams{ //nolint:wrapcheck
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      domain.TaskStatusOpen,
+		Priority:    priority,
+		DueDate:     req.DueDate,
+		ParentID:    domain.TaskID(req.ParentID),
+		ProjectID:   domain.ProjectID(req.ProjectID),
+	})
+}
+
+func newListTasksQuery(ctx *gin.Context) (*core.ListTasksQuery, error) {
+	var query core.ListTasksQuery
+
+	limit, err := queryLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Limit = limit
+
+	if value := ctx.Query("cursor"); value != "" {
+		cursor, err := core.DecodeCursor(value)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		query.Cursor = cursor
+	}
+
+	sort, err := core.ParseTaskSort(ctx.Query("sort"))
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	query.Sort = sort
+
+	err = parseTaskFilter(ctx, &query.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &query, nil
+}
+
+func parseTaskFilter(ctx *gin.Context, filter *core.TaskFilter) error {
+	for _, value := range ctx.QueryArray("status") {
+		if value == "" {
+			continue
+		}
+
+		status, err := domain.ParseTaskStatus(value)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	for _, value := range ctx.QueryArray("priority") {
+		if value == "" {
+			continue
+		}
+
+		priority, err := domain.ParseTaskPriority(value)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		filter.Priorities = append(filter.Priorities, priority)
+	}
+
+	filter.Tags = ctx.QueryArray("tag")
+
+	var err error
+
+	filter.TagMatch, err = core.ParseTagMatch(ctx.Query("tag_match"))
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	filter.Search = ctx.Query("q")
+
+	filter.DueBefore, err = queryTime(ctx, "due_before")
+	if err != nil {
+		return err
+	}
+
+	filter.DueAfter, err = queryTime(ctx, "due_after")
+
+	return err
+}
+
+// queryLimit limit 쿼리 파라미터를 읽습니다. 없으면 0이며, 범위는 각 쿼리의 Validate에서 검사합니다.
+func queryLimit(ctx *gin.Context) (int, error) {
+	value := ctx.Query("limit")
+	if value == "" {
+		return 0, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: limit must be an integer", core.ErrInvalidQuery)
+	}
+
+	return limit, nil
+}
+
+// queryTime RFC 3339 형식의 쿼리 파라미터를 해석합니다. 값이 없으면 nil입니다.
+func queryTime(ctx *gin.Context, key string) (*time.Time, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	ret, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be an RFC 3339 date-time", core.ErrInvalidQuery, key)
+	}
+
+	ret = ret.UTC()
+
+	return &ret, nil
 }
 
 // GetTask ID로 특정 Task 조회
 // @Summary Get task
-// @Description ID로 특정 Task를 조회합니다
+// @Description ID로 특정 Task를 조회합니다. progress에는 모든 하위 Task의 완료 현황이 담깁니다
 // @Tags tasks
 // @Produce json
 // @Param id path string true "Task ID"
 // @Success 200 {object} TaskResponse
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
 // @Router /tasks/{id} [get]
 func (h *Handler) GetTask(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID가 필요합니다"})
+		respondError(ctx, errMissingTaskID)
 
 		return
 	}
 
 	task, err := h.service.GetTask(ctx, domain.TaskID(id))
 	if err != nil {
-		if errors.Is(err, core.ErrTaskNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": "Task를 찾을 수 없습니다"})
+		respondError(ctx, err)
 
-			return
-		}
+		return
+	}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	progress, err := h.service.TaskProgress(ctx, task.ID())
+	if err != nil {
+		respondError(ctx, err)
 
 		return
 	}
 
-	response := TaskResponse{
-		ID:          string(task.ID()),
-		Title:       task.Title(),
-		Description: task.Description(),
+	responses, err := h.newTaskResponses(ctx, []*domain.Task{task})
+	if err != nil {
+		respondError(ctx, err)
+
+		return
 	}
 
-	ctx.JSON(http.StatusOK, response)
+	responses[0].Progress = newProgressResponse(progress)
+
+	setETag(ctx, task)
+	ctx.JSON(http.StatusOK, responses[0])
 }
 
 // UpdateTask Task 수정
 // @Summary Update task
-// @Description Task를 수정합니다
+// @Description Task의 제목과 설명을 수정합니다. 상태와 상위 Task(parent_id), 프로젝트(project_id)는 변경되지 않습니다
+// @Description 우선순위(priority)를 생략하면 저장된 우선순위를 유지합니다
+// @Description If-Match 헤더가 현재 ETag와 다르면 412를, 동시 수정이 감지되면 409를 반환합니다
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID"
+// @Param If-Match header string false "ETag returned by a previous read"
 // @Param task body CreateTaskRequest true "Updated task information"
 // @Success 200 {object} TaskResponse
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
 // @Router /tasks/{id} [put]
 func (h *Handler) UpdateTask(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID가 필요합니다"})
+		respondError(ctx, errMissingTaskID)
 
 		return
 	}
 
 	var req CreateTaskRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
 
 		return
 	}
 
-	spec := domain.NewTaskSpec(req.Title, req.Description)
+	version, err := ifMatchVersion(ctx)
+	if err != nil {
+		respondError(ctx, err)
 
-	ret, err := h.service.UpdateTask(ctx, domain.TaskID(id), spec)
+		return
+	}
+
+	spec, err := newTaskSpec(&req)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 
 		return
 	}
 
-	response := TaskResponse{
-		ID:          string(ret.ID()),
-		Title:       ret.Title(),
-		Description: ret.Description(),
+	ret, err := h.service.UpdateTask(ctx, domain.TaskID(id), spec, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
 	}
 
-	ctx.JSON(http.StatusOK, response)
+	h.respondTask(ctx, http.StatusOK, ret)
+}
+
+// PatchTask Task 부분 수정
+// @Summary Patch task
+// @Description 요청에 포함된 필드만 수정합니다. application/merge-patch+json(RFC 7396)과
+// @Description application/json-patch+json(RFC 6902)을 지원하며, 상태는 전이 엔드포인트로만 변경됩니다
+// @Description 변경할 수 있는 필드는 title, description, priority, due_date입니다
+// @Description merge patch에서 description을 null로 보내면 빈 문자열이, due_date를 null로 보내면 마감일 없음이 됩니다
+// @Description JSON Patch의 test 연산이 실패하면 409를 반환합니다
+// @Tags tasks
+// @Accept application/merge-patch+json,application/json-patch+json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Param patch body object true "Merge patch object or JSON Patch operations"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 415 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id} [patch]
+func (h *Handler) PatchTask(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	version, err := ifMatchVersion(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	patch, version, err := h.decodePatch(ctx, domain.TaskID(id), version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.PatchTask(ctx, domain.TaskID(id), patch, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
 }
 
 // DeleteTask Task 삭제
 // @Summary Delete task
-// @Description Task를 삭제합니다
+// @Description Task를 휴지통으로 옮깁니다. 하위 Task는 subtasks가 orphan(기본값)이면 최상위 Task가 되고, cascade이면 함께 옮겨집니다
+// @Description 휴지통의 Task는 복원하거나 영구 삭제할 수 있으며, 보관 기간이 지나면 자동으로 영구 삭제됩니다
 // @Tags tasks
 // @Param id path string true "Task ID"
+// @Param subtasks query string false "What to do with subtasks" Enums(orphan, cascade)
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
 // @Router /tasks/{id} [delete]
 func (h *Handler) DeleteTask(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID가 필요합니다"})
+		respondError(ctx, errMissingTaskID)
 
 		return
 	}
 
-	err := h.service.DeleteTask(ctx, domain.TaskID(id))
+	policy, err := flow.ParseSubtaskPolicy(ctx.Query("subtasks"))
 	if err != nil {
-		if errors.Is(err, core.ErrTaskNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": "Task를 찾을 수 없습니다"})
+		respondError(ctx, err)
 
-			return
-		}
+		return
+	}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	err = h.service.DeleteTask(ctx, domain.TaskID(id), policy)
+	if err != nil {
+		respondError(ctx, err)
 
 		return
 	}
 
 	ctx.Status(http.StatusNoContent)
 }
+
+// TransitionTask Task 상태 전이
+// @Summary Transition task status
+// @Description 액션을 적용하여 Task의 상태를 전이합니다. 허용되지 않는 전이는 409를 반환합니다
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Param transition body TransitionTaskRequest true "Transition action"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/transitions [post]
+func (h *Handler) TransitionTask(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	var req TransitionTaskRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	action, err := domain.ParseTaskAction(req.Action)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	version, err := ifMatchVersion(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.TransitionTask(ctx, domain.TaskID(id), action, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// decodePatch Content-Type에 맞게 요청 본문을 TaskPatch로 변환합니다.
+// JSON Patch는 test 연산을 위해 현재 Task를 읽고, 그 사이의 수정을 감지하도록 읽은 버전을 반환합니다.
+func (h *Handler) decodePatch(
+	ctx *gin.Context,
+	id domain.TaskID,
+	version int,
+) (*domain.TaskPatch, int, error) {
+	body, err := ctx.GetRawData()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	switch ctx.ContentType() {
+	case mergePatchContentType, binding.MIMEJSON:
+		patch, err := parseMergePatch(body)
+
+		return patch, version, err
+	case jsonPatchContentType:
+		task, err := h.service.GetTask(ctx, id)
+		if err != nil {
+			return nil, 0, err //nolint:wrapcheck
+		}
+
+		if version == 0 {
+			version = task.Version()
+		}
+
+		patch, err := parseJSONPatch(body, task)
+
+		return patch, version, err
+	default:
+		return nil, 0, fmt.Errorf("%w: %s", errUnsupportedMediaType, ctx.ContentType())
+	}
+}
+
+// respondTask Task를 ETag와 함께 응답합니다.
+func (h *Handler) respondTask(ctx *gin.Context, status int, task *domain.Task) {
+	responses, err := h.newTaskResponses(ctx, []*domain.Task{task})
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	setETag(ctx, task)
+	ctx.JSON(status, responses[0])
+}
+
+// respondTasks 페이지 없이 Task 목록을 응답합니다.
+func (h *Handler) respondTasks(ctx *gin.Context, tasks []*domain.Task) {
+	h.respondTaskPage(ctx, &core.TaskPage{Tasks: tasks, NextCursor: nil})
+}
+
+func (h *Handler) respondTaskPage(ctx *gin.Context, page *core.TaskPage) {
+	responses, err := h.newTaskResponses(ctx, page.Tasks)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	response := &TaskListResponse{Tasks: responses, NextCursor: ""}
+	if page.NextCursor != nil {
+		response.NextCursor = page.NextCursor.Encode()
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// newTaskResponses Task를 응답 형식으로 바꾸고, 끝나지 않은 선행 Task가 있는 Task에 blocked를 표시합니다.
+func (h *Handler) newTaskResponses(ctx *gin.Context, tasks []*domain.Task) ([]*TaskResponse, error) {
+	blocked, err := h.service.BlockedTaskIDs(ctx, tasks)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	responses := make([]*TaskResponse, len(tasks))
+	for i, task := range tasks {
+		responses[i] = newTaskResponse(task)
+		responses[i].Blocked = blocked[task.ID()]
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,1522 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/blob"
+	"github.com/neatflowcv/tasker/internal/pkg/config"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/i18n"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/fake"
+)
+
+func newTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+
+	return newServiceRouter(t, flow.NewService(fake.NewRepository()))
+}
+
+// newServiceRouter service를 사용하는 테스트용 router를 생성합니다.
+func newServiceRouter(t *testing.T, service *flow.Service) *gin.Engine {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{ //nolint:exhaustruct
+		APIBasePath:    "/tasker/v1",
+		RequestTimeout: time.Minute,
+	}
+
+	catalog, err := i18n.NewCatalog("en")
+	if err != nil {
+		t.Fatalf("NewCatalog: %v", err)
+	}
+
+	return newRouter(cfg, catalog, NewHandler(service))
+}
+
+type statusCase struct {
+	name   string
+	method string
+	path   string
+	body   string
+	header map[string]string
+	want   int
+}
+
+func TestErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	assertStatuses(t, newTestRouter(t), []statusCase{
+		{"get missing", http.MethodGet, "/tasks/missing", "", nil, http.StatusNotFound},
+		{"put missing", http.MethodPut, "/tasks/missing", `{"title":"t"}`, nil, http.StatusNotFound},
+		{"delete missing", http.MethodDelete, "/tasks/missing", "", nil, http.StatusNotFound},
+		{"delete with bad policy", http.MethodDelete, "/tasks/missing?subtasks=keep", "", nil, http.StatusBadRequest},
+		{"move missing", http.MethodPost, "/tasks/missing/move", `{"parent_id":""}`, nil, http.StatusNotFound},
+		{"list children of missing", http.MethodGet, "/tasks/missing/children", "", nil, http.StatusNotFound},
+		{"transition missing", http.MethodPost, "/tasks/missing/transitions", `{"action":"start"}`, nil, http.StatusNotFound},
+		{"create without title", http.MethodPost, "/tasks", `{}`, nil, http.StatusUnprocessableEntity},
+		{"create with blank title", http.MethodPost, "/tasks", `{"title":"  "}`, nil, http.StatusUnprocessableEntity},
+		{
+			"create with control character", http.MethodPost, "/tasks", `{"title":"a\u0000b"}`, nil,
+			http.StatusUnprocessableEntity,
+		},
+		{"create with mistyped title", http.MethodPost, "/tasks", `{"title":1}`, nil, http.StatusBadRequest},
+		{"create with bad priority", http.MethodPost, "/tasks", `{"title":"t","priority":"x"}`, nil, http.StatusBadRequest},
+		{"list with bad due_before", http.MethodGet, "/tasks?due_before=tomorrow", "", nil, http.StatusBadRequest},
+		{"list with bad cursor", http.MethodGet, "/tasks?cursor=%21", "", nil, http.StatusBadRequest},
+		{"list with bad limit", http.MethodGet, "/tasks?limit=1000", "", nil, http.StatusBadRequest},
+		{"list with bad tag_match", http.MethodGet, "/tasks?tag=a&tag_match=some", "", nil, http.StatusBadRequest},
+		{"restore missing", http.MethodPost, "/tasks/missing/restore", "", nil, http.StatusNotFound},
+		{"purge missing", http.MethodDelete, "/tasks/trash/missing", "", nil, http.StatusNotFound},
+		{"depend on missing task", http.MethodPut, "/tasks/missing/dependencies/other", "", nil, http.StatusNotFound},
+		{"order without ids", http.MethodGet, "/tasks/execution-order", "", nil, http.StatusBadRequest},
+		{"order missing task", http.MethodGet, "/tasks/execution-order?id=missing", "", nil, http.StatusNotFound},
+		{"get missing tag", http.MethodGet, "/tags/missing", "", nil, http.StatusNotFound},
+		{"tag missing task", http.MethodPut, "/tasks/missing/tags/missing", "", nil, http.StatusNotFound},
+		{"create tag with bad name", http.MethodPost, "/tags", `{"name":"a b"}`, nil, http.StatusUnprocessableEntity},
+		{
+			"create tag with bad color", http.MethodPost, "/tags", `{"name":"a","color":"red"}`, nil,
+			http.StatusUnprocessableEntity,
+		},
+		{
+			"put with malformed If-Match", http.MethodPut, "/tasks/missing", `{"title":"t"}`,
+			map[string]string{"If-Match": "W/\"1\""}, http.StatusPreconditionFailed,
+		},
+		{
+			"create as the system actor", http.MethodPost, "/tasks", `{"title":"t"}`,
+			map[string]string{"X-Actor": "system"}, http.StatusBadRequest,
+		},
+		{
+			"list as the anonymous actor", http.MethodGet, "/tasks", "",
+			map[string]string{"X-Actor": " Anonymous "}, http.StatusBadRequest,
+		},
+	})
+}
+
+func TestContextErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	cancelled, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	expired, cancel := context.WithDeadline(t.Context(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context //nolint:containedctx
+		want int
+	}{
+		{"deadline exceeded", expired, http.StatusGatewayTimeout},
+		{"cancelled", cancelled, statusClientClosedRequest},
+	}
+
+	for _, tc := range tests {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequestWithContext(tc.ctx, http.MethodGet, "/tasker/v1/tasks", nil))
+
+		if rec.Code != tc.want {
+			t.Errorf("%s status = %d, want %d", tc.name, rec.Code, tc.want)
+		}
+	}
+}
+
+// assertStatuses 각 요청을 병렬로 보내 응답 상태 코드를 확인합니다.
+func assertStatuses(t *testing.T, router *gin.Engine, tests []statusCase) {
+	t.Helper()
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), tc.method, "/tasker/v1"+tc.path, strings.NewReader(tc.body))
+			for key, value := range tc.header {
+				req.Header.Set(key, value)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tc.want {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tc.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestProblemResponse(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/tasker/v1/tasks", strings.NewReader(`{}`))
+	req.Header.Set("X-Request-ID", "test-request")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "application/problem+json") {
+		t.Fatalf("Content-Type = %q, want application/problem+json", got)
+	}
+
+	var problem Problem
+
+	err := json.Unmarshal(rec.Body.Bytes(), &problem)
+	if err != nil {
+		t.Fatalf("decode problem: %v", err)
+	}
+
+	if problem.Status != http.StatusUnprocessableEntity || problem.Code != codeValidationFailed ||
+		problem.RequestID != "test-request" || problem.Instance != "/tasker/v1/tasks" {
+		t.Fatalf("problem = %+v", problem)
+	}
+
+	if len(problem.Errors) != 1 || problem.Errors[0].Field != "title" || problem.Errors[0].Code != "required" {
+		t.Fatalf("field errors = %+v, want title required", problem.Errors)
+	}
+}
+
+func TestProblemDetailCause(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/tasks?sort=bogus", `parameters. Cause: unknown sort field "bogus"`},
+		{"/tasks?due_before=tomorrow", "Cause: due_before must be an RFC 3339 date-time"},
+		{"/tasks/missing", "No task exists with the given ID."},
+	}
+
+	for _, tc := range tests {
+		var problem Problem
+
+		decodeJSON(t, serve(t, router, http.MethodGet, tc.path, "", ""), &problem)
+
+		if !strings.HasSuffix(problem.Detail, tc.want) {
+			t.Errorf("GET %s detail = %q, want suffix %q", tc.path, problem.Detail, tc.want)
+		}
+	}
+}
+
+func TestLocalizeKeepsVary(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := i18n.NewCatalog("en")
+	if err != nil {
+		t.Fatalf("NewCatalog: %v", err)
+	}
+
+	router := gin.New()
+	router.Use(func(ctx *gin.Context) {
+		ctx.Header("Vary", "Origin")
+	}, localize(catalog))
+	router.GET("/", func(ctx *gin.Context) {
+		ctx.Status(http.StatusNoContent)
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil))
+
+	if got := rec.Header().Values("Vary"); fmt.Sprint(got) != "[Origin Accept-Language]" {
+		t.Fatalf("Vary = %v, want [Origin Accept-Language]", got)
+	}
+}
+
+func serve(t *testing.T, router *gin.Engine, method, path, contentType, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), method, "/tasker/v1"+path, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func decodeTask(t *testing.T, rec *httptest.ResponseRecorder) TaskResponse {
+	t.Helper()
+
+	var task TaskResponse
+
+	err := json.Unmarshal(rec.Body.Bytes(), &task)
+	if err != nil {
+		t.Fatalf("decode task: %v: %s", err, rec.Body.String())
+	}
+
+	return task
+}
+
+func TestPatchTask(t *testing.T) {
+	t.Parallel()
+
+	const renamed = "renamed"
+
+	router := newTestRouter(t)
+
+	created := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"title","description":"description"}`))
+	path := "/tasks/" + created.ID
+
+	rec := serve(t, router, http.MethodPatch, path, mergePatchContentType, `{"title":"renamed"}`)
+	if got := decodeTask(t, rec); got.Title != renamed || got.Description != "description" || got.Version != 2 {
+		t.Fatalf("merge patch title = %+v", got)
+	}
+
+	rec = serve(t, router, http.MethodPatch, path, mergePatchContentType, `{"description":null}`)
+	if got := decodeTask(t, rec); got.Title != renamed || got.Description != "" {
+		t.Fatalf("merge patch null description = %+v", got)
+	}
+
+	rec = serve(t, router, http.MethodPatch, path, jsonPatchContentType,
+		`[{"op":"test","path":"/title","value":"renamed"},{"op":"replace","path":"/description","value":"again"}]`)
+	if got := decodeTask(t, rec); got.Title != renamed || got.Description != "again" {
+		t.Fatalf("json patch = %+v", got)
+	}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        int
+	}{
+		{"null title", mergePatchContentType, `{"title":null}`, http.StatusUnprocessableEntity},
+		{
+			"too long description", mergePatchContentType, `{"description":"` + strings.Repeat("x", 10001) + `"}`,
+			http.StatusUnprocessableEntity,
+		},
+		{"read-only status", mergePatchContentType, `{"status":"done"}`, http.StatusBadRequest},
+		{"unknown field", mergePatchContentType, `{"owner":"me"}`, http.StatusBadRequest},
+		{"bad priority", mergePatchContentType, `{"priority":"someday"}`, http.StatusBadRequest},
+		{"bad due date", mergePatchContentType, `{"due_date":"tomorrow"}`, http.StatusBadRequest},
+		{"non-object", mergePatchContentType, `[]`, http.StatusBadRequest},
+		{"failed test", jsonPatchContentType, `[{"op":"test","path":"/title","value":"x"}]`, http.StatusConflict},
+		{"unsupported op", jsonPatchContentType, `[{"op":"move","path":"/title"}]`, http.StatusBadRequest},
+		{"unsupported media type", "text/plain", `title`, http.StatusUnsupportedMediaType},
+	}
+
+	for _, tc := range tests {
+		rec := serve(t, router, http.MethodPatch, path, tc.contentType, tc.body)
+		if rec.Code != tc.want {
+			t.Errorf("%s: status = %d, want %d: %s", tc.name, rec.Code, tc.want, rec.Body.String())
+		}
+	}
+}
+
+func TestPatchTaskPriorityAndDueDate(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	created := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"title"}`))
+	path := "/tasks/" + created.ID
+
+	rec := serve(t, router, http.MethodPatch, path, mergePatchContentType,
+		`{"priority":"high","due_date":"2026-01-31T18:00:00+09:00"}`)
+	if got := decodeTask(t, rec); got.Priority != "high" || got.DueDate == nil || got.Title != "title" {
+		t.Fatalf("merge patch = %+v", got)
+	}
+
+	rec = serve(t, router, http.MethodPatch, path, jsonPatchContentType,
+		`[{"op":"test","path":"/due_date","value":"2026-01-31T09:00:00Z"},{"op":"remove","path":"/due_date"}]`)
+	if got := decodeTask(t, rec); got.DueDate != nil || got.Priority != "high" {
+		t.Fatalf("json patch = %+v", got)
+	}
+}
+
+func TestTaskStamps(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	now := time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	cfg := &config.Config{ //nolint:exhaustruct
+		APIBasePath:    "/tasker/v1",
+		RequestTimeout: time.Minute,
+	}
+
+	catalog, err := i18n.NewCatalog("en")
+	if err != nil {
+		t.Fatalf("NewCatalog: %v", err)
+	}
+
+	router := newRouter(cfg, catalog, NewHandler(flow.NewServiceWithClock(fake.NewRepository(), clock)))
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/tasker/v1/tasks",
+		strings.NewReader(`{"title":"title"}`))
+	req.Header.Set("X-Actor", "alice")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	created := decodeTask(t, rec)
+	if !created.CreatedAt.Equal(now) || created.CreatedBy != "alice" ||
+		!created.UpdatedAt.Equal(now) || created.UpdatedBy != "alice" {
+		t.Fatalf("created task = %+v", created)
+	}
+
+	now = now.Add(time.Hour)
+
+	rec = serve(t, router, http.MethodPatch, "/tasks/"+created.ID, mergePatchContentType, `{"title":"renamed"}`)
+
+	updated := decodeTask(t, rec)
+	if !updated.CreatedAt.Equal(created.CreatedAt) || !updated.UpdatedAt.Equal(now) ||
+		updated.UpdatedBy != flow.AnonymousActor {
+		t.Fatalf("updated task = %+v", updated)
+	}
+}
+
+func TestListOverdueTasks(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	now := time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	cfg := &config.Config{ //nolint:exhaustruct
+		APIBasePath:    "/tasker/v1",
+		RequestTimeout: time.Minute,
+	}
+
+	catalog, err := i18n.NewCatalog("en")
+	if err != nil {
+		t.Fatalf("NewCatalog: %v", err)
+	}
+
+	service := flow.NewServiceWithClock(fake.NewRepository(), func() time.Time { return now })
+	router := newRouter(cfg, catalog, NewHandler(service))
+
+	later := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"later","due_date":"2026-01-01T12:00:00Z"}`))
+	sooner := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"sooner","priority":"urgent","due_date":"2026-01-01T09:00:00+09:00"}`))
+
+	done := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"done","due_date":"2026-01-01T00:00:00Z"}`))
+	serve(t, router, http.MethodPost, "/tasks/"+done.ID+"/transitions", "application/json", `{"action":"complete"}`)
+	serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"upcoming","due_date":"2026-01-03T00:00:00Z"}`)
+
+	rec := serve(t, router, http.MethodGet, "/tasks/overdue", "", "")
+
+	var list TaskListResponse
+
+	err = json.Unmarshal(rec.Body.Bytes(), &list)
+	if err != nil {
+		t.Fatalf("decode list: %v: %s", err, rec.Body.String())
+	}
+
+	if len(list.Tasks) != 2 || list.Tasks[0].ID != sooner.ID || list.Tasks[1].ID != later.ID {
+		t.Fatalf("overdue tasks = %s, want [sooner later]", rec.Body.String())
+	}
+
+	if list.Tasks[0].Priority != "urgent" || list.Tasks[1].Priority != "medium" {
+		t.Fatalf("priorities = %q, %q", list.Tasks[0].Priority, list.Tasks[1].Priority)
+	}
+}
+
+func TestQueryTime(t *testing.T) {
+	t.Parallel()
+
+	want := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{"2026-01-01T00:00:00Z", "2026-01-01T09:00:00+09:00", "2025-12-31T14:00:00-10:00"} {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?since="+url.QueryEscape(value), nil)
+
+		got, err := queryTime(ctx, "since")
+		if err != nil || got == nil || !got.Equal(want) || got.Location() != time.UTC {
+			t.Fatalf("queryTime(%s) = %v, %v, want %v", value, got, err, want)
+		}
+	}
+}
+
+func TestTaskTags(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	var work, urgent TagResponse
+
+	decodeJSON(t, serve(t, router, http.MethodPost, "/tags", "application/json", `{"name":"Work","color":"#36F0aa"}`),
+		&work)
+	decodeJSON(t, serve(t, router, http.MethodPost, "/tags", "application/json", `{"name":"urgent"}`), &urgent)
+
+	if work.Name != "work" || work.Color != "#36f0aa" {
+		t.Fatalf("created tag = %+v, want normalised name and color", work)
+	}
+
+	rec := serve(t, router, http.MethodPost, "/tags", "application/json", `{"name":"WORK"}`)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("duplicate tag status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+
+	both := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"both"}`))
+	workOnly := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"work"}`))
+
+	serve(t, router, http.MethodPut, "/tasks/"+both.ID+"/tags/"+work.ID, "", "")
+	serve(t, router, http.MethodPut, "/tasks/"+both.ID+"/tags/"+urgent.ID, "", "")
+	tagged := decodeTask(t, serve(t, router, http.MethodPut, "/tasks/"+workOnly.ID+"/tags/"+work.ID, "", ""))
+
+	if len(tagged.Tags) != 1 || tagged.Tags[0].ID != work.ID || tagged.Version != 2 {
+		t.Fatalf("tagged task = %+v, want tag work at version 2", tagged)
+	}
+
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks?tag=work&tag=urgent", "", ""), "[both work]")
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks?tag=work&tag=urgent&tag_match=all", "", ""), "[both]")
+
+	rec = serve(t, router, http.MethodDelete, "/tags/"+work.ID, "", "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("delete tag status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+
+	// 태그를 삭제하면 태그가 붙어 있던 Task의 버전과 ETag가 바뀝니다.
+	rec = serve(t, router, http.MethodGet, "/tasks/"+workOnly.ID, "", "")
+
+	untagged := decodeTask(t, rec)
+	if len(untagged.Tags) != 0 || rec.Header().Get("ETag") != `"3"` {
+		t.Fatalf("task after tag deletion = %+v, ETag %s, want no tags with ETag \"3\"", untagged, rec.Header().Get("ETag"))
+	}
+}
+
+func TestChecklist(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	task := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"task"}`))
+	if task.ChecklistProgress == nil {
+		t.Fatal("created task has no checklist_progress")
+	}
+
+	path := "/tasks/" + task.ID + "/checklist"
+	assertChecklist(t, router, path, "[]")
+
+	serve(t, router, http.MethodPost, path, "application/json", `{"text":" write tests "}`)
+	serve(t, router, http.MethodPost, path, "application/json", `{"text":"review"}`)
+	added := decodeTask(t, serve(t, router, http.MethodPost, path, "application/json", `{"text":"deploy"}`))
+
+	if got := checklistTexts(added.Checklist); got != "[write tests review deploy]" || added.Version != 4 {
+		t.Fatalf("checklist = %s at version %d, want three items in insertion order at version 4", got, added.Version)
+	}
+
+	first, second, third := added.Checklist[0].ID, added.Checklist[1].ID, added.Checklist[2].ID
+
+	checked := decodeTask(t, serve(t, router, http.MethodPost, path+"/"+second+"/check", "", ""))
+	if progress := checked.ChecklistProgress; progress.Completed != 1 || progress.Total != 3 || progress.Percent != 33 {
+		t.Fatalf("checklist progress = %+v, want 1 of 3 (33%%)", progress)
+	}
+
+	again := decodeTask(t, serve(t, router, http.MethodPost, path+"/"+second+"/check", "", ""))
+	if again.Version != checked.Version {
+		t.Fatalf("check of a checked item version = %d, want %d", again.Version, checked.Version)
+	}
+
+	reordered := decodeTask(t, serve(t, router, http.MethodPut, path+"/order", "application/json",
+		`{"item_ids":["`+third+`","`+first+`","`+second+`"]}`))
+	if got := checklistTexts(reordered.Checklist); got != "[deploy write tests review]" || !reordered.Checklist[2].Done {
+		t.Fatalf("reordered checklist = %s, want deploy first and review still checked", got)
+	}
+
+	decodeTask(t, serve(t, router, http.MethodPost, path+"/"+second+"/uncheck", "", ""))
+	removed := decodeTask(t, serve(t, router, http.MethodDelete, path+"/"+third, "", ""))
+
+	assertChecklist(t, router, path, "[write tests review]")
+	assertChecklistErrors(t, router, task.ID, first, removed.Version)
+}
+
+// assertChecklist 체크리스트 조회 결과가 완료되지 않은 want 항목들인지 확인합니다.
+func assertChecklist(t *testing.T, router *gin.Engine, path, want string) {
+	t.Helper()
+
+	var checklist ChecklistResponse
+
+	decodeJSON(t, serve(t, router, http.MethodGet, path, "", ""), &checklist)
+
+	if got := checklistTexts(checklist.Items); got != want || checklist.Progress.Completed != 0 {
+		t.Fatalf("checklist = %s progress %+v, want unchecked %s", got, checklist.Progress, want)
+	}
+}
+
+// assertChecklistErrors 체크리스트 요청의 에러 응답 상태 코드를 확인합니다. first는 남아 있는 항목의 ID입니다.
+func assertChecklistErrors(t *testing.T, router *gin.Engine, taskID, first string, version int) {
+	t.Helper()
+
+	path := "/tasks/" + taskID + "/checklist"
+
+	assertStatuses(t, router, []statusCase{
+		{"add blank item", http.MethodPost, path, `{"text":" "}`, nil, http.StatusUnprocessableEntity},
+		{"add multi-line item", http.MethodPost, path, `{"text":"a\nb"}`, nil, http.StatusUnprocessableEntity},
+		{"add to missing task", http.MethodPost, "/tasks/missing/checklist", `{"text":"a"}`, nil, http.StatusNotFound},
+		{"check missing item", http.MethodPost, path + "/missing/check", "", nil, http.StatusNotFound},
+		{"remove missing item", http.MethodDelete, path + "/missing", "", nil, http.StatusNotFound},
+		{"reorder with missing item", http.MethodPut, path + "/order", `{"item_ids":["` + first + `"]}`, nil,
+			http.StatusUnprocessableEntity},
+		{
+			"check with stale If-Match", http.MethodPost, path + "/" + first + "/check", "",
+			map[string]string{"If-Match": `"` + strconv.Itoa(version-1) + `"`}, http.StatusPreconditionFailed,
+		},
+	})
+}
+
+func checklistTexts(items []*ChecklistItemResponse) string {
+	texts := make([]string, len(items))
+	for i, item := range items {
+		texts[i] = item.Text
+	}
+
+	return fmt.Sprint(texts)
+}
+
+func decodeJSON(t *testing.T, rec *httptest.ResponseRecorder, v any) {
+	t.Helper()
+
+	err := json.Unmarshal(rec.Body.Bytes(), v)
+	if err != nil {
+		t.Fatalf("decode response: %v: %s", err, rec.Body.String())
+	}
+}
+
+func assertTaskTitles(t *testing.T, rec *httptest.ResponseRecorder, want string) {
+	t.Helper()
+
+	var list TaskListResponse
+
+	decodeJSON(t, rec, &list)
+
+	titles := make([]string, len(list.Tasks))
+	for i, task := range list.Tasks {
+		titles[i] = task.Title
+	}
+
+	if got := fmt.Sprint(titles); got != want {
+		t.Fatalf("task titles = %s, want %s", got, want)
+	}
+}
+
+func TestProjects(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	project := createProject(t, router, `{"key":"ops","name":"운영","default_priority":"urgent"}`)
+	if project.Key != "OPS" || project.DefaultPriority != "urgent" {
+		t.Fatalf("project = %s %s, want OPS urgent", project.Key, project.DefaultPriority)
+	}
+
+	body := `{"title":"defaulted","project_id":"` + project.ID + `"}`
+	defaulted := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", body))
+	body = `{"title":"explicit","priority":"low","project_id":"` + project.ID + `"}`
+	explicit := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", body))
+	serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"unassigned"}`)
+
+	got := fmt.Sprint(defaulted.Key, defaulted.Priority, explicit.Key, explicit.Priority)
+	if got != "OPS-1urgentOPS-2low" {
+		t.Fatalf("task keys and priorities = %s, want OPS-1 urgent and OPS-2 low", got)
+	}
+
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/projects/"+project.ID+"/tasks", "", ""),
+		"[defaulted explicit]")
+
+	if byKey := decodeTask(t, serve(t, router, http.MethodGet, "/tasks/by-key/ops-2", "", "")); byKey.ID != explicit.ID {
+		t.Fatalf("task by key = %s, want %s", byKey.ID, explicit.ID)
+	}
+
+	rec := serve(t, router, http.MethodPut, "/projects/"+project.ID, "application/json", `{"name":"운영팀"}`)
+
+	decodeJSON(t, rec, &project)
+
+	if got = fmt.Sprint(project.Key, project.Name, project.DefaultPriority); got != "OPS운영팀medium" {
+		t.Fatalf("updated project = %s, want OPS 운영팀 medium", got)
+	}
+
+	if etag := rec.Header().Get("ETag"); etag != `"2"` || project.Version != 2 {
+		t.Fatalf("updated project ETag = %s, version = %d, want \"2\" and 2", etag, project.Version)
+	}
+
+	assertProjectErrors(t, router, project.ID, defaulted.ID)
+}
+
+func TestUpdateTaskKeepsPriority(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	project := createProject(t, router, `{"key":"OPS","name":"운영","default_priority":"urgent"}`)
+	body := `{"title":"defaulted","project_id":"` + project.ID + `"}`
+	defaulted := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", body))
+	body = `{"title":"explicit","priority":"low","project_id":"` + project.ID + `"}`
+	explicit := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", body))
+
+	// 우선순위를 빼고 전체 수정하면 medium으로 되돌리지 않고 저장된 우선순위를 유지합니다.
+	defaulted = decodeTask(t, serve(t, router, http.MethodPut, "/tasks/"+defaulted.ID, "application/json",
+		`{"title":"renamed"}`))
+	explicit = decodeTask(t, serve(t, router, http.MethodPut, "/tasks/"+explicit.ID, "application/json",
+		`{"title":"explicit"}`))
+
+	if got := fmt.Sprint(defaulted.Title, defaulted.Priority, explicit.Priority); got != "renamedurgentlow" {
+		t.Fatalf("updated tasks = %s, want renamed urgent and low", got)
+	}
+
+	explicit = decodeTask(t, serve(t, router, http.MethodPut, "/tasks/"+explicit.ID, "application/json",
+		`{"title":"explicit","priority":"high"}`))
+	if explicit.Priority != "high" {
+		t.Fatalf("priority = %s, want high", explicit.Priority)
+	}
+}
+
+func createProject(t *testing.T, router *gin.Engine, body string) ProjectResponse {
+	t.Helper()
+
+	rec := serve(t, router, http.MethodPost, "/projects", "application/json", body)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create project status = %d, want 201: %s", rec.Code, rec.Body.String())
+	}
+
+	var project ProjectResponse
+
+	decodeJSON(t, rec, &project)
+
+	return project
+}
+
+// assertProjectErrors 프로젝트 요청의 에러 응답 상태 코드를 확인합니다. 프로젝트는 한 번 수정되었고,
+// taskID는 프로젝트에 속한 Task의 ID입니다.
+func assertProjectErrors(t *testing.T, router *gin.Engine, projectID, taskID string) {
+	t.Helper()
+
+	assertStatuses(t, router, []statusCase{
+		{"create with duplicate key", http.MethodPost, "/projects", `{"key":"OPS","name":"a"}`, nil, http.StatusConflict},
+		{"create with invalid key", http.MethodPost, "/projects", `{"key":"1X","name":"a"}`, nil,
+			http.StatusUnprocessableEntity},
+		{"create with blank name", http.MethodPost, "/projects", `{"key":"DEV","name":" "}`, nil,
+			http.StatusUnprocessableEntity},
+		{"get missing project", http.MethodGet, "/projects/missing", "", nil, http.StatusNotFound},
+		{"list tasks of missing project", http.MethodGet, "/projects/missing/tasks", "", nil, http.StatusNotFound},
+		{"delete project with tasks", http.MethodDelete, "/projects/" + projectID, "", nil, http.StatusConflict},
+		{"create task in missing project", http.MethodPost, "/tasks", `{"title":"a","project_id":"missing"}`, nil,
+			http.StatusUnprocessableEntity},
+		{"get task by missing key", http.MethodGet, "/tasks/by-key/OPS-99", "", nil, http.StatusNotFound},
+		{"patch key", http.MethodPatch, "/tasks/" + taskID, `{"key":"OPS-9"}`,
+			map[string]string{"Content-Type": "application/merge-patch+json"}, http.StatusBadRequest},
+		{"update with stale If-Match", http.MethodPut, "/projects/" + projectID, `{"name":"a"}`,
+			map[string]string{"If-Match": `"1"`}, http.StatusPreconditionFailed},
+		{"set columns with stale If-Match", http.MethodPut, "/projects/" + projectID + "/columns",
+			`{"columns":[{"key":"all","name":"전체","statuses":["open"]}]}`,
+			map[string]string{"If-Match": `"1"`}, http.StatusPreconditionFailed},
+	})
+}
+
+func TestBoard(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	project := createProject(t, router, `{"key":"OPS","name":"운영"}`)
+	rec := serve(t, router, http.MethodPut, "/projects/"+project.ID+"/columns", "application/json",
+		`{"columns":[{"key":"todo","name":"할 일","statuses":["open"]},`+
+			`{"key":"Doing","name":"진행 중","statuses":["in_progress","blocked"]},`+
+			`{"key":"done","name":"완료","statuses":["done"]}]}`)
+	decodeJSON(t, rec, &project)
+
+	if got := fmt.Sprint(project.Columns[1]); got != "&{doing 진행 중 [in_progress blocked]}" {
+		t.Fatalf("columns[1] = %s, want doing with in_progress and blocked", got)
+	}
+
+	tasks := make(map[string]TaskResponse)
+
+	for _, title := range []string{"a", "b", "c"} {
+		body := `{"title":"` + title + `","project_id":"` + project.ID + `"}`
+		tasks[title] = decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", body))
+	}
+
+	assertBoard(t, router, project.ID, "todo[a b c] doing[] done[]")
+
+	moveOnBoard(t, router, tasks["c"].ID, `{"column":"todo"}`)
+	assertBoard(t, router, project.ID, "todo[c a b] doing[] done[]")
+
+	if moved := moveOnBoard(t, router, tasks["a"].ID, `{"column":"doing"}`); moved.Status != "in_progress" {
+		t.Fatalf("status after moving to doing = %s, want in_progress", moved.Status)
+	}
+
+	moveOnBoard(t, router, tasks["c"].ID, `{"after_id":"`+tasks["b"].ID+`"}`)
+	assertBoard(t, router, project.ID, "todo[b c] doing[a] done[]")
+
+	moveOnBoard(t, router, tasks["b"].ID, `{"column":"done"}`)
+	moveOnBoard(t, router, tasks["c"].ID, `{"column":"done"}`)
+
+	if moved := moveOnBoard(t, router, tasks["b"].ID, `{"column":"todo"}`); moved.Status != "open" {
+		t.Fatalf("status after moving done card to todo = %s, want open", moved.Status)
+	}
+
+	assertBoard(t, router, project.ID, "todo[b] doing[a] done[c]")
+
+	unassigned := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"x"}`))
+
+	assertStatuses(t, router, []statusCase{
+		{"duplicate status", http.MethodPut, "/projects/" + project.ID + "/columns",
+			`{"columns":[{"key":"a","name":"a","statuses":["open"]},{"key":"b","name":"b","statuses":["open"]}]}`,
+			nil, http.StatusUnprocessableEntity},
+		{"board of missing project", http.MethodGet, "/projects/missing/board", "", nil, http.StatusNotFound},
+		{"task without project", http.MethodPost, "/tasks/" + unassigned.ID + "/move", `{"column":"todo"}`, nil,
+			http.StatusConflict},
+		{"missing column", http.MethodPost, "/tasks/" + tasks["b"].ID + "/move", `{"column":"review"}`, nil,
+			http.StatusUnprocessableEntity},
+		{"after_id in another column", http.MethodPost, "/tasks/" + tasks["b"].ID + "/move",
+			`{"after_id":"` + tasks["a"].ID + `"}`, nil, http.StatusUnprocessableEntity},
+		{"done card to doing", http.MethodPost, "/tasks/" + tasks["c"].ID + "/move", `{"column":"doing"}`, nil,
+			http.StatusConflict},
+	})
+}
+
+func moveOnBoard(t *testing.T, router *gin.Engine, taskID, body string) TaskResponse {
+	t.Helper()
+
+	rec := serve(t, router, http.MethodPost, "/tasks/"+taskID+"/move", "application/json", body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("move status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+
+	return decodeTask(t, rec)
+}
+
+// assertBoard 보드의 열마다 놓인 Task의 제목을 "열[제목 ...]" 형식으로 비교합니다.
+func assertBoard(t *testing.T, router *gin.Engine, projectID, want string) {
+	t.Helper()
+
+	var board BoardResponse
+
+	decodeJSON(t, serve(t, router, http.MethodGet, "/projects/"+projectID+"/board", "", ""), &board)
+
+	lanes := make([]string, len(board.Columns))
+
+	for index, lane := range board.Columns {
+		titles := make([]string, len(lane.Tasks))
+		for i, task := range lane.Tasks {
+			titles[i] = task.Title
+		}
+
+		lanes[index] = lane.Key + fmt.Sprint(titles)
+	}
+
+	if got := strings.Join(lanes, " "); got != want {
+		t.Fatalf("board = %s, want %s", got, want)
+	}
+}
+
+func TestSubtasks(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	root := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"root"}`))
+	child := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"child","parent_id":"`+root.ID+`"}`))
+
+	// 생성 요청으로는 상태를 정할 수 없으므로 전이로 완료합니다.
+	grandchild := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"grandchild","status":"done","parent_id":"`+child.ID+`"}`))
+	if grandchild.Status != "open" {
+		t.Fatalf("created status = %s, want open", grandchild.Status)
+	}
+
+	serve(t, router, http.MethodPost, "/tasks/"+grandchild.ID+"/transitions", "application/json",
+		`{"action":"complete"}`)
+
+	if rec := serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"lost","parent_id":"missing"}`); rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("create under missing parent status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+
+	progress := decodeTask(t, serve(t, router, http.MethodGet, "/tasks/"+root.ID, "", "")).Progress
+	if progress == nil || progress.Total != 2 || progress.Completed != 1 || progress.Percent != 50 {
+		t.Fatalf("progress = %+v, want 1 of 2 completed", progress)
+	}
+
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks/"+root.ID+"/children", "", ""), "[child]")
+
+	rec := serve(t, router, http.MethodPost, "/tasks/"+root.ID+"/move", "application/json",
+		`{"parent_id":"`+child.ID+`"}`)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("move under subtask status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+
+	moved := decodeTask(t, serve(t, router, http.MethodPost, "/tasks/"+child.ID+"/move", "application/json",
+		`{"parent_id":""}`))
+	if moved.ParentID != "" || moved.Version != 2 {
+		t.Fatalf("moved task = %+v, want root task at version 2", moved)
+	}
+
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks/"+root.ID+"/children", "", ""), "[]")
+}
+
+// TestMoveWithoutParent parent_id를 생략하면 상위 Task를 바꾸지 않는지 확인합니다.
+func TestMoveWithoutParent(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	root := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"root"}`))
+	child := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"child","parent_id":"`+root.ID+`"}`))
+
+	rec := serve(t, router, http.MethodPost, "/tasks/"+child.ID+"/move", "application/json", `{}`)
+	if got := decodeTask(t, rec); got.ParentID != root.ID || got.Version != child.Version {
+		t.Fatalf("move without parent_id = %+v, want the task unchanged under %s", got, root.ID)
+	}
+}
+
+// TestParentCycle 동시에 옮겨져 상위 관계에 순환이 생겨도 하위 Task를 따라가는 요청이 끝나는지 확인합니다.
+func TestParentCycle(t *testing.T) {
+	t.Parallel()
+
+	repo := fake.NewRepository()
+	router := newServiceRouter(t, flow.NewService(repo))
+
+	parent := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"parent"}`))
+	child := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"child","parent_id":"`+parent.ID+`"}`))
+	other := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"other"}`))
+
+	stored, err := repo.GetTask(t.Context(), domain.TaskID(parent.ID))
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+
+	// 검사를 거치지 않고 저장소에서 바로 옮겨 동시 이동으로 생긴 순환을 흉내 냅니다.
+	_, err = repo.UpdateTask(t.Context(), stored.WithParent(domain.TaskID(child.ID)))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	assertStatuses(t, router, []statusCase{
+		{"progress", http.MethodGet, "/tasks/" + parent.ID, "", nil, http.StatusConflict},
+		{"move under cycle", http.MethodPost, "/tasks/" + other.ID + "/move", `{"parent_id":"` + child.ID + `"}`, nil,
+			http.StatusConflict},
+		{"cascade delete", http.MethodDelete, "/tasks/" + parent.ID + "?subtasks=cascade", "", nil, http.StatusConflict},
+	})
+}
+
+func TestDeleteTaskWithSubtasks(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	root := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"root"}`))
+	child := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"child","parent_id":"`+root.ID+`"}`))
+	serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"grandchild","parent_id":"`+child.ID+`"}`)
+
+	rec := serve(t, router, http.MethodDelete, "/tasks/"+root.ID, "", "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+
+	orphan := decodeTask(t, serve(t, router, http.MethodGet, "/tasks/"+child.ID, "", ""))
+	if orphan.ParentID != "" || orphan.Progress == nil || orphan.Progress.Total != 1 {
+		t.Fatalf("orphaned child = %+v, want root task with one subtask", orphan)
+	}
+
+	rec = serve(t, router, http.MethodDelete, "/tasks/"+child.ID+"?subtasks=cascade", "", "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("cascade delete status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks", "", ""), "[]")
+}
+
+func TestDependencies(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	design := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"design"}`))
+	build := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"build"}`))
+	ship := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"ship"}`))
+
+	for _, path := range []string{
+		"/tasks/" + ship.ID + "/dependencies/" + build.ID,
+		"/tasks/" + build.ID + "/dependencies/" + design.ID,
+	} {
+		if rec := serve(t, router, http.MethodPut, path, "", ""); rec.Code != http.StatusNoContent {
+			t.Fatalf("add dependency status = %d, want %d", rec.Code, http.StatusNoContent)
+		}
+	}
+
+	assertStatuses(t, router, []statusCase{
+		{"cycle", http.MethodPut, "/tasks/" + design.ID + "/dependencies/" + ship.ID, "", nil, http.StatusConflict},
+		{"self", http.MethodPut, "/tasks/" + design.ID + "/dependencies/" + design.ID, "", nil, http.StatusConflict},
+		{
+			"missing dependency", http.MethodPut, "/tasks/" + design.ID + "/dependencies/missing", "", nil,
+			http.StatusUnprocessableEntity,
+		},
+		{
+			"remove missing edge", http.MethodDelete, "/tasks/" + design.ID + "/dependencies/" + ship.ID, "", nil,
+			http.StatusNotFound,
+		},
+	})
+
+	blocked := decodeTask(t, serve(t, router, http.MethodGet, "/tasks/"+build.ID, "", ""))
+	if !blocked.Blocked || blocked.Version != 1 {
+		t.Fatalf("build = %+v, want blocked at version 1", blocked)
+	}
+
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks/"+build.ID+"/dependencies", "", ""), "[design]")
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks/"+build.ID+"/dependents", "", ""), "[ship]")
+	assertTaskTitles(t, serve(t, router, http.MethodGet,
+		"/tasks/execution-order?id="+ship.ID+"&id="+design.ID+"&id="+build.ID, "", ""), "[design build ship]")
+
+	serve(t, router, http.MethodPost, "/tasks/"+design.ID+"/transitions", "application/json", `{"action":"complete"}`)
+
+	if decodeTask(t, serve(t, router, http.MethodGet, "/tasks/"+build.ID, "", "")).Blocked {
+		t.Fatal("build is still blocked after design is done")
+	}
+}
+
+func TestTrash(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	root := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"root"}`))
+	child := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json",
+		`{"title":"child","parent_id":"`+root.ID+`"}`))
+
+	serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"kept"}`)
+
+	serve(t, router, http.MethodDelete, "/tasks/"+root.ID+"?subtasks=cascade", "", "")
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks", "", ""), "[kept]")
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks/trash", "", ""), "[root child]")
+
+	if rec := serve(t, router, http.MethodGet, "/tasks/"+root.ID, "", ""); rec.Code != http.StatusNotFound {
+		t.Fatalf("get deleted task status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	rec := serve(t, router, http.MethodPost, "/tasks/"+child.ID+"/restore", "", "")
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("restore under deleted parent status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+
+	restored := decodeTask(t, serve(t, router, http.MethodPost, "/tasks/"+root.ID+"/restore", "", ""))
+	if restored.DeletedAt != nil || restored.Version != 1 {
+		t.Fatalf("restored task = %+v, want live task at version 1", restored)
+	}
+
+	rec = serve(t, router, http.MethodDelete, "/tasks/trash/"+child.ID, "", "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("purge status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+
+	assertTaskTitles(t, serve(t, router, http.MethodGet, "/tasks/trash", "", ""), "[]")
+
+	rec = serve(t, router, http.MethodPost, "/tasks/"+child.ID+"/restore", "", "")
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("restore purged task status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestPurgeExpiredTasks(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	service := flow.NewServiceWithClock(fake.NewRepository(), func() time.Time { return now })
+
+	for _, title := range []string{"old", "recent"} {
+		spec, err := domain.NewTaskSpec(domain.TaskSpecParams{ //nolint:exhaustruct
+			Title:  title,
+			Status: domain.TaskStatusOpen,
+		})
+		if err != nil {
+			t.Fatalf("NewTaskSpec: %v", err)
+		}
+
+		task, err := service.CreateTask(t.Context(), spec)
+		if err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+
+		err = service.DeleteTask(t.Context(), task.ID(), flow.SubtaskPolicyOrphan)
+		if err != nil {
+			t.Fatalf("DeleteTask: %v", err)
+		}
+
+		now = now.Add(time.Hour)
+	}
+
+	purgeExpiredTasks(t.Context(), service, time.Hour+time.Minute)
+
+	page, err := service.ListDeletedTasks(t.Context(), &core.ListTasksQuery{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("ListDeletedTasks: %v", err)
+	}
+
+	if len(page.Tasks) != 1 || page.Tasks[0].Title() != "recent" {
+		t.Fatalf("trash after purge = %v, want only recent", page.Tasks)
+	}
+}
+
+func TestRunServer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	// ctx가 이미 취소되었으므로 서버는 바로 정상 종료됩니다.
+	server := &http.Server{Addr: "127.0.0.1:0", ReadHeaderTimeout: time.Second} //nolint:exhaustruct
+
+	err := runServer(ctx, server, time.Second)
+	if err != nil {
+		t.Fatalf("runServer after cancel = %v, want nil", err)
+	}
+
+	server = &http.Server{Addr: "127.0.0.1:-1", ReadHeaderTimeout: time.Second} //nolint:exhaustruct
+
+	err = runServer(t.Context(), server, time.Second)
+	if err == nil {
+		t.Fatal("runServer with an invalid address = nil, want an error")
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	task := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"draft"}`))
+	serve(t, router, http.MethodPatch, "/tasks/"+task.ID, mergePatchContentType, `{"title":"renamed"}`)
+	serve(t, router, http.MethodDelete, "/tasks/"+task.ID, "", "")
+	serve(t, router, http.MethodDelete, "/tasks/trash/"+task.ID, "", "")
+
+	var history AuditListResponse
+
+	decodeJSON(t, serve(t, router, http.MethodGet, "/tasks/"+task.ID+"/history", "", ""), &history)
+
+	actions := make([]string, len(history.Entries))
+	for i, entry := range history.Entries {
+		actions[i] = entry.Action
+	}
+
+	if got := fmt.Sprint(actions); got != "[create update delete purge]" {
+		t.Fatalf("history actions = %s", got)
+	}
+
+	update := history.Entries[1]
+
+	want := FieldChangeResponse{Field: patchFieldTitle, Before: "draft", After: "renamed"}
+	if len(update.Changes) != 1 || *update.Changes[0] != want || update.Actor != flow.AnonymousActor {
+		t.Fatalf("update entry = %+v", update)
+	}
+
+	var page AuditListResponse
+
+	decodeJSON(t, serve(t, router, http.MethodGet, "/audit?limit=3", "", ""), &page)
+
+	if len(page.Entries) != 3 || page.NextCursor == "" {
+		t.Fatalf("first audit page = %+v, want 3 entries and a cursor", page)
+	}
+
+	decodeJSON(t, serve(t, router, http.MethodGet, "/audit?actor=nobody", "", ""), &page)
+
+	if len(page.Entries) != 0 {
+		t.Fatalf("audit by unknown actor = %+v, want no entries", page)
+	}
+
+	assertStatuses(t, router, []statusCase{
+		{"bad since", http.MethodGet, "/audit?since=yesterday", "", nil, http.StatusBadRequest},
+		{
+			"empty range", http.MethodGet, "/audit?since=2026-01-02T00:00:00Z&until=2026-01-01T00:00:00Z", "", nil,
+			http.StatusBadRequest,
+		},
+	})
+}
+
+func TestRevisions(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+	task := createRevisedTask(t, router)
+
+	var revisions RevisionListResponse
+
+	decodeJSON(t, serve(t, router, http.MethodGet, "/tasks/"+task.ID+"/revisions", "", ""), &revisions)
+
+	if len(revisions.Revisions) != 3 || revisions.Revisions[0].Title != "draft" {
+		t.Fatalf("revisions = %+v, want 3 revisions starting with the created task", revisions.Revisions)
+	}
+
+	var diff RevisionDiffResponse
+
+	decodeJSON(t, serve(t, router, http.MethodGet, "/tasks/"+task.ID+"/revisions/1/diff?to=2", "", ""), &diff)
+
+	want := FieldChangeResponse{Field: patchFieldTitle, Before: "draft", After: "renamed"}
+	if diff.From != 1 || diff.To != 2 || len(diff.Changes) != 1 || *diff.Changes[0] != want {
+		t.Fatalf("diff = %+v", diff)
+	}
+
+	decodeJSON(t, serve(t, router, http.MethodGet, "/tasks/"+task.ID+"/revisions/2/diff", "", ""), &diff)
+
+	if diff.To != 3 || len(diff.Changes) != 1 || diff.Changes[0].Field != "status" {
+		t.Fatalf("diff with current version = %+v, want only the status change", diff)
+	}
+
+	assertStatuses(t, router, []statusCase{
+		{"revision not a number", http.MethodGet, "/tasks/" + task.ID + "/revisions/first", "", nil, http.StatusBadRequest},
+		{"revision zero", http.MethodGet, "/tasks/" + task.ID + "/revisions/0", "", nil, http.StatusBadRequest},
+		{"missing revision", http.MethodGet, "/tasks/" + task.ID + "/revisions/99", "", nil, http.StatusNotFound},
+		{"missing task", http.MethodGet, "/tasks/missing/revisions", "", nil, http.StatusNotFound},
+		{"bad diff target", http.MethodGet, "/tasks/" + task.ID + "/revisions/1/diff?to=x", "", nil, http.StatusBadRequest},
+	})
+}
+
+func TestRevertTask(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+	task := createRevisedTask(t, router)
+
+	reverted := decodeTask(t, serve(t, router, http.MethodPost, "/tasks/"+task.ID+"/revisions/1/revert", "", ""))
+	if reverted.Title != "draft" || reverted.Status != "in_progress" || reverted.Version != 4 {
+		t.Fatalf("reverted task = %+v, want title of revision 1 and current status at version 4", reverted)
+	}
+
+	again := decodeTask(t, serve(t, router, http.MethodPost, "/tasks/"+task.ID+"/revisions/1/revert", "", ""))
+	if again.Version != reverted.Version {
+		t.Fatalf("revert without changes version = %d, want %d", again.Version, reverted.Version)
+	}
+
+	assertStatuses(t, router, []statusCase{
+		{
+			"revert with stale If-Match", http.MethodPost, "/tasks/" + task.ID + "/revisions/1/revert", "",
+			map[string]string{"If-Match": `"1"`}, http.StatusPreconditionFailed,
+		},
+		{"revert to missing revision", http.MethodPost, "/tasks/" + task.ID + "/revisions/99/revert", "", nil,
+			http.StatusNotFound},
+	})
+}
+
+// createRevisedTask 제목을 바꾼 뒤 시작하여 세 리비전이 있는 Task를 만듭니다.
+func createRevisedTask(t *testing.T, router *gin.Engine) TaskResponse {
+	t.Helper()
+
+	task := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"draft"}`))
+	serve(t, router, http.MethodPatch, "/tasks/"+task.ID, mergePatchContentType, `{"title":"renamed"}`)
+	serve(t, router, http.MethodPost, "/tasks/"+task.ID+"/transitions", "application/json", `{"action":"start"}`)
+
+	return task
+}
+
+func TestComments(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	task := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"task"}`))
+	other := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"other"}`))
+	path := "/tasks/" + task.ID + "/comments"
+
+	first := createComment(t, router, path, `{"body":"  **first**  "}`)
+	reply := createComment(t, router, path, `{"body":"reply","parent_id":"`+first.ID+`"}`)
+	createComment(t, router, path, `{"body":"nested","parent_id":"`+reply.ID+`"}`)
+	createComment(t, router, path, `{"body":"second"}`)
+
+	var list CommentListResponse
+
+	decodeJSON(t, serve(t, router, http.MethodGet, path, "", ""), &list)
+
+	if len(list.Comments) != 2 || list.Comments[0].Body != "**first**" || list.Comments[0].Author != flow.AnonymousActor {
+		t.Fatalf("comments = %+v, want two threads starting with the trimmed first comment", list.Comments)
+	}
+
+	if replies := list.Comments[0].Replies; len(replies) != 1 || len(replies[0].Replies) != 1 {
+		t.Fatalf("replies = %+v, want a reply with a nested reply", replies)
+	}
+
+	assertStatuses(t, router, []statusCase{
+		{"empty body", http.MethodPost, path, `{"body":"  "}`, nil, http.StatusUnprocessableEntity},
+		{"reply to another task's comment", http.MethodPost, "/tasks/" + other.ID + "/comments",
+			`{"body":"b","parent_id":"` + reply.ID + `"}`, nil, http.StatusUnprocessableEntity},
+		{"missing task", http.MethodGet, "/tasks/missing/comments", "", nil, http.StatusNotFound},
+		{"comment of another task", http.MethodDelete, "/tasks/" + other.ID + "/comments/" + reply.ID, "", nil,
+			http.StatusNotFound},
+	})
+}
+
+func TestEditAndDeleteComment(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	task := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"task"}`))
+	path := "/tasks/" + task.ID + "/comments"
+
+	var first, reply CommentResponse
+
+	decodeJSON(t, serveAs(t, router, http.MethodPost, path, "alice", `{"body":"first"}`), &first)
+	decodeJSON(t, serveAs(t, router, http.MethodPost, path, "bob", `{"body":"reply","parent_id":"`+first.ID+`"}`), &reply)
+	createComment(t, router, path, `{"body":"second"}`)
+
+	anonymous := createComment(t, router, path, `{"body":"anonymous"}`)
+
+	forbidden := []struct {
+		name, method, id, actor string
+	}{
+		{"update by another actor", http.MethodPut, first.ID, "bob"},
+		{"update without actor", http.MethodPut, first.ID, ""},
+		{"update anonymous comment", http.MethodPut, anonymous.ID, ""},
+		{"delete anonymous comment", http.MethodDelete, anonymous.ID, ""},
+	}
+
+	for _, tc := range forbidden {
+		rec := serveAs(t, router, tc.method, path+"/"+tc.id, tc.actor, `{"body":"hijacked"}`)
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("%s status = %d, want %d", tc.name, rec.Code, http.StatusForbidden)
+		}
+	}
+
+	var edited CommentResponse
+
+	decodeJSON(t, serveAs(t, router, http.MethodPut, path+"/"+first.ID, "alice", `{"body":"edited"}`), &edited)
+
+	if edited.Body != "edited" || !edited.Edited {
+		t.Fatalf("edited comment = %+v", edited)
+	}
+
+	// bob의 답글도 alice의 댓글과 함께 삭제됩니다.
+	if rec := serveAs(t, router, http.MethodDelete, path+"/"+first.ID, "alice", ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+
+	var list CommentListResponse
+
+	decodeJSON(t, serve(t, router, http.MethodGet, path, "", ""), &list)
+
+	if len(list.Comments) != 2 || list.Comments[0].Body != "second" {
+		t.Fatalf("comments after delete = %+v, want the second and anonymous threads", list.Comments)
+	}
+
+	rec := serve(t, router, http.MethodPost, path, "application/json", `{"body":"b","parent_id":"`+reply.ID+`"}`)
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("reply to deleted comment status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+}
+
+func createComment(t *testing.T, router *gin.Engine, path, body string) CommentResponse {
+	t.Helper()
+
+	rec := serve(t, router, http.MethodPost, path, "application/json", body)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create comment status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+
+	var comment CommentResponse
+
+	decodeJSON(t, rec, &comment)
+
+	return comment
+}
+
+// serveAs actor를 X-Actor 헤더로 보내 JSON 요청을 처리합니다.
+func serveAs(t *testing.T, router *gin.Engine, method, path, actor, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), method, "/tasker/v1"+path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", actor)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// pngHeader PNG 파일 시그니처로, 본문이 이것으로 시작하면 image/png로 판별됩니다.
+const pngHeader = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+
+func TestAttachments(t *testing.T) {
+	t.Parallel()
+
+	router := newTestRouter(t)
+
+	task := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"task"}`))
+	path := "/tasks/" + task.ID + "/attachments"
+
+	// 클라이언트가 보낸 Content-Type은 무시하고 본문으로 판별합니다.
+	attachment := uploadAttachment(t, router, path, "스크린샷.png", pngHeader)
+
+	sum := sha256.Sum256([]byte(pngHeader))
+	if attachment.Name != "스크린샷.png" || attachment.ContentType != "image/png" ||
+		attachment.Size != int64(len(pngHeader)) || attachment.Checksum != hex.EncodeToString(sum[:]) {
+		t.Fatalf("uploaded attachment = %+v", attachment)
+	}
+
+	uploadAttachment(t, router, path, "log.txt", "line 1\nline 2\n")
+
+	var list AttachmentListResponse
+
+	decodeJSON(t, serve(t, router, http.MethodGet, path, "", ""), &list)
+
+	if len(list.Attachments) != 2 || list.Attachments[1].ContentType != "text/plain; charset=utf-8" {
+		t.Fatalf("attachments = %+v, want png and text in upload order", list.Attachments)
+	}
+
+	assertDownload(t, serve(t, router, http.MethodGet, path+"/"+attachment.ID, "", ""), pngHeader, map[string]string{
+		"Content-Type":           "image/png",
+		"Content-Disposition":    "attachment; filename*=utf-8''%EC%8A%A4%ED%81%AC%EB%A6%B0%EC%83%B7.png",
+		"X-Content-Type-Options": "nosniff",
+	})
+
+	if rec := serve(t, router, http.MethodDelete, path+"/"+attachment.ID, "", ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+
+	if rec := serveMultipart(t, router, "/tasks/missing/attachments", "a.txt", "a"); rec.Code != http.StatusNotFound {
+		t.Fatalf("upload to missing task status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	assertStatuses(t, router, []statusCase{
+		{"download deleted", http.MethodGet, path + "/" + attachment.ID, "", nil, http.StatusNotFound},
+		{"upload without file", http.MethodPost, path, "{}", nil, http.StatusBadRequest},
+	})
+}
+
+func assertDownload(t *testing.T, rec *httptest.ResponseRecorder, body string, headers map[string]string) {
+	t.Helper()
+
+	if rec.Code != http.StatusOK || rec.Body.String() != body {
+		t.Fatalf("download = %d %q, want %q", rec.Code, rec.Body.String(), body)
+	}
+
+	for header, want := range headers {
+		if got := rec.Header().Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestAttachmentTooLarge(t *testing.T) {
+	t.Parallel()
+
+	router := newServiceRouter(t, flow.NewService(fake.NewRepository(), flow.WithMaxAttachmentSize(8)))
+
+	task := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"task"}`))
+	path := "/tasks/" + task.ID + "/attachments"
+
+	uploadAttachment(t, router, path, "small.txt", "8 bytes!")
+
+	// 최대 크기를 조금 넘으면 flow.Service가, multipart 여유분까지 넘으면 요청 본문 제한이 거부합니다.
+	for _, size := range []int{9, 1 << 20} {
+		rec := serveMultipart(t, router, path, "large.txt", strings.Repeat("x", size))
+		if rec.Code != http.StatusRequestEntityTooLarge || !strings.Contains(rec.Body.String(), "attachment_too_large") {
+			t.Errorf("upload %d bytes = %d %s, want %d", size, rec.Code, rec.Body.String(), http.StatusRequestEntityTooLarge)
+		}
+	}
+}
+
+func TestPurgeTaskDeletesAttachmentBlobs(t *testing.T) {
+	t.Parallel()
+
+	store := &recordingStore{MemoryStore: blob.NewMemoryStore(), keys: nil}
+	router := newServiceRouter(t, flow.NewService(fake.NewRepository(), flow.WithBlobStore(store)))
+
+	task := decodeTask(t, serve(t, router, http.MethodPost, "/tasks", "application/json", `{"title":"task"}`))
+	uploadAttachment(t, router, "/tasks/"+task.ID+"/attachments", "a.txt", "a")
+	uploadAttachment(t, router, "/tasks/"+task.ID+"/attachments", "b.txt", "b")
+
+	serve(t, router, http.MethodDelete, "/tasks/"+task.ID, "", "")
+
+	if rec := serve(t, router, http.MethodDelete, "/tasks/trash/"+task.ID, "", ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("purge status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+
+	if len(store.keys) != 2 {
+		t.Fatalf("stored keys = %v, want 2", store.keys)
+	}
+
+	for _, key := range store.keys {
+		_, err := store.Get(t.Context(), key)
+		if !errors.Is(err, blob.ErrNotFound) {
+			t.Errorf("Get(%q) after purge error = %v, want %v", key, err, blob.ErrNotFound)
+		}
+	}
+}
+
+// recordingStore 저장한 키를 기록하는 blob.Store입니다.
+type recordingStore struct {
+	*blob.MemoryStore
+
+	keys []string
+}
+
+func (s *recordingStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	s.keys = append(s.keys, key)
+
+	return s.MemoryStore.Put(ctx, key, body, size, contentType) //nolint:wrapcheck
+}
+
+func uploadAttachment(t *testing.T, router *gin.Engine, path, name, body string) AttachmentResponse {
+	t.Helper()
+
+	rec := serveMultipart(t, router, path, name, body)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("upload status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+
+	var attachment AttachmentResponse
+
+	decodeJSON(t, rec, &attachment)
+
+	return attachment
+}
+
+// serveMultipart body를 name이라는 파일로 file 필드에 담아 multipart/form-data로 업로드합니다.
+func serveMultipart(t *testing.T, router *gin.Engine, path, name, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	writer := multipart.NewWriter(&buf)
+
+	part, err := writer.CreateFormFile("file", name)
+	if err == nil {
+		_, err = io.WriteString(part, body)
+	}
+
+	if err == nil {
+		err = writer.Close()
+	}
+
+	if err != nil {
+		t.Fatalf("write multipart body: %v", err)
+	}
+
+	return serve(t, router, http.MethodPost, path, writer.FormDataContentType(), buf.String())
+}
@@ -1,40 +1,156 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/docs"
 	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/blob"
+	"github.com/neatflowcv/tasker/internal/pkg/config"
+	"github.com/neatflowcv/tasker/internal/pkg/i18n"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
 	"github.com/neatflowcv/tasker/internal/pkg/repository/fake"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/orm"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
-
-	_ "github.com/neatflowcv/tasker/docs" // Import for side effects
 )
 
 // @title Tasker API
 // @version 1.0
 // @description Task 관리를 위한 REST API
+// @description 에러는 RFC 7807 application/problem+json 형식으로 반환되며, code 필드로 에러 종류를 구분합니다
+// @description 에러 메시지는 Accept-Language 헤더(en, ko)에 따라 지역화됩니다
 // @host localhost:8080
 // @BasePath /tasker/v1
 
 func main() {
+	// 설정 로드
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal("Failed to load config: ", err)
+	}
+
+	gin.SetMode(cfg.GinMode)
+	docs.SwaggerInfo.BasePath = cfg.APIBasePath
+
 	// Repository 초기화
-	repo := fake.NewRepository()
-	service := flow.NewService(repo)
+	repo, err := newRepository(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize repository: ", err)
+	}
+
+	// 첨부 파일 blob 저장소 초기화
+	blobs, err := newBlobStore(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize blob storage: ", err)
+	}
+
+	service := flow.NewService(repo, flow.WithBlobStore(blobs), flow.WithMaxAttachmentSize(cfg.Attachment.MaxSize))
 
 	// Handler 초기화
 	taskHandler := NewHandler(service)
 
+	// 메시지 카탈로그 초기화
+	catalog, err := i18n.NewCatalog(cfg.DefaultLanguage)
+	if err != nil {
+		log.Fatal("Failed to load message catalog: ", err)
+	}
+
+	// Router 초기화
+	router := newRouter(cfg, catalog, taskHandler)
+
+	addr := ":" + strconv.Itoa(cfg.Port)
+
+	log.Printf("Starting Tasker API server on %s (storage: %s, blob: %s)", addr, cfg.Storage, cfg.Attachment.Backend)
+	log.Printf("Swagger UI available at: http://localhost%s%s/swagger/index.html", addr, cfg.APIBasePath)
+
+	// SIGINT나 SIGTERM을 받으면 백그라운드 작업과 서버를 정상 종료합니다.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	var background sync.WaitGroup
+
+	// 휴지통 보관 기간이 지난 Task 영구 삭제
+	startTrashRetention(ctx, &background, service, cfg.Trash)
+
+	err = runServer(ctx, &http.Server{ //nolint:exhaustruct
+		Addr:              addr,
+		Handler:           router,
+		ReadHeaderTimeout: cfg.RequestTimeout,
+	}, cfg.RequestTimeout)
+
+	stop()
+	background.Wait()
+
+	if err != nil {
+		log.Fatal("Failed to run server: ", err)
+	}
+
+	log.Print("Tasker API server stopped")
+}
+
+// runServer ctx가 취소될 때까지 server로 요청을 처리합니다. ctx가 취소되면 새 연결을 받지 않고 처리 중인 요청이 끝나기를
+// 최대 drainTimeout 동안 기다립니다.
+func runServer(ctx context.Context, server *http.Server, drainTimeout time.Duration) error {
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("failed to listen: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), drainTimeout)
+	defer cancel()
+
+	err := server.Shutdown(shutdownCtx)
+	if err != nil {
+		return fmt.Errorf("failed to shut down server: %w", err)
+	}
+
+	return nil
+}
+
+func newRouter(cfg *config.Config, catalog *i18n.Catalog, taskHandler *Handler) *gin.Engine {
+	registerJSONFieldNames()
+
 	// Gin 라우터 설정
-	router := gin.Default()
+	router := gin.New()
+	router.Use(requestID, localize(catalog), gin.Logger(), gin.CustomRecovery(func(ctx *gin.Context, _ any) {
+		respondError(ctx, errInternal)
+	}), actor)
+	// gin.Context가 요청 context의 취소와 deadline을 Repository까지 전달하도록 합니다.
+	router.ContextWithFallback = true
+
+	// 요청 타임아웃 미들웨어 추가
+	router.Use(func(ctx *gin.Context) {
+		timeoutCtx, cancel := context.WithTimeout(ctx.Request.Context(), cfg.RequestTimeout)
+		defer cancel()
+
+		ctx.Request = ctx.Request.WithContext(timeoutCtx)
+		ctx.Next()
+	})
 
 	// CORS 미들웨어 추가
 	router.Use(func(ctx *gin.Context) {
 		ctx.Header("Access-Control-Allow-Origin", "*")
-		ctx.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		ctx.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization")
+		ctx.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		ctx.Header("Access-Control-Allow-Headers",
+			"Origin, Content-Type, Accept, Accept-Language, Authorization, If-Match, X-Request-ID, X-Actor")
+		ctx.Header("Access-Control-Expose-Headers", "ETag, X-Request-ID, Content-Language, Content-Disposition")
 
 		if ctx.Request.Method == http.MethodOptions {
 			ctx.AbortWithStatus(http.StatusNoContent)
@@ -46,28 +162,150 @@ func main() {
 	})
 
 	// API 라우트 그룹 설정
-	v1 := router.Group("/tasker/v1")
+	v1 := router.Group(cfg.APIBasePath)
 	{
-		tasks := v1.Group("/tasks")
-		{
-			tasks.POST("", taskHandler.CreateTask)
-			tasks.GET("", taskHandler.ListTasks)
-			tasks.GET("/:id", taskHandler.GetTask)
-			tasks.PUT("/:id", taskHandler.UpdateTask)
-			tasks.DELETE("/:id", taskHandler.DeleteTask)
-		}
+		registerRoutes(v1, taskHandler)
 
 		// Swagger 문서 라우트
 		v1.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 		v1.GET("/", func(c *gin.Context) {
-			c.Redirect(http.StatusMovedPermanently, "/tasker/v1/swagger/index.html")
+			c.Redirect(http.StatusMovedPermanently, cfg.APIBasePath+"/swagger/index.html")
 		})
 	}
 
-	log.Println("Starting Tasker API server on :8080")
-	log.Println("Swagger UI available at: http://localhost:8080/swagger/index.html")
+	router.NoRoute(func(ctx *gin.Context) {
+		respondError(ctx, errRouteNotFound)
+	})
+
+	return router
+}
+
+// registerRoutes API 리소스 라우트를 등록합니다.
+func registerRoutes(v1 *gin.RouterGroup, taskHandler *Handler) {
+	registerTaskRoutes(v1.Group("/tasks"), taskHandler)
+
+	tags := v1.Group("/tags")
+	{
+		tags.POST("", taskHandler.CreateTag)
+		tags.GET("", taskHandler.ListTags)
+		tags.GET("/:id", taskHandler.GetTag)
+		tags.PUT("/:id", taskHandler.UpdateTag)
+		tags.DELETE("/:id", taskHandler.DeleteTag)
+	}
+
+	projects := v1.Group("/projects")
+	{
+		projects.POST("", taskHandler.CreateProject)
+		projects.GET("", taskHandler.ListProjects)
+		projects.GET("/:id", taskHandler.GetProject)
+		projects.PUT("/:id", taskHandler.UpdateProject)
+		projects.DELETE("/:id", taskHandler.DeleteProject)
+		projects.GET("/:id/tasks", taskHandler.ListProjectTasks)
+		projects.PUT("/:id/columns", taskHandler.SetProjectColumns)
+		projects.GET("/:id/board", taskHandler.GetBoard)
+	}
+
+	v1.GET("/audit", taskHandler.ListAuditEntries)
+}
+
+// registerTaskRoutes Task와 Task에 딸린 하위 리소스의 라우트를 등록합니다.
+func registerTaskRoutes(tasks *gin.RouterGroup, taskHandler *Handler) {
+	tasks.POST("", taskHandler.CreateTask)
+	tasks.GET("", taskHandler.ListTasks)
+	tasks.GET("/overdue", taskHandler.ListOverdueTasks)
+	tasks.GET("/execution-order", taskHandler.ExecutionOrder)
+	tasks.GET("/trash", taskHandler.ListTrash)
+	tasks.GET("/by-key/:key", taskHandler.GetTaskByKey)
+	tasks.DELETE("/trash/:id", taskHandler.PurgeTask)
+	tasks.GET("/:id", taskHandler.GetTask)
+	tasks.PUT("/:id", taskHandler.UpdateTask)
+	tasks.PATCH("/:id", taskHandler.PatchTask)
+	tasks.DELETE("/:id", taskHandler.DeleteTask)
+	tasks.POST("/:id/transitions", taskHandler.TransitionTask)
+	tasks.GET("/:id/children", taskHandler.ListSubtasks)
+	tasks.POST("/:id/move", taskHandler.MoveTask)
+	tasks.POST("/:id/restore", taskHandler.RestoreTask)
+	tasks.GET("/:id/history", taskHandler.TaskHistory)
+	tasks.GET("/:id/revisions", taskHandler.ListRevisions)
+	tasks.GET("/:id/revisions/:rev", taskHandler.GetRevision)
+	tasks.GET("/:id/revisions/:rev/diff", taskHandler.DiffRevisions)
+	tasks.POST("/:id/revisions/:rev/revert", taskHandler.RevertTask)
+	tasks.GET("/:id/comments", taskHandler.ListComments)
+	tasks.POST("/:id/comments", taskHandler.CreateComment)
+	tasks.PUT("/:id/comments/:comment_id", taskHandler.UpdateComment)
+	tasks.DELETE("/:id/comments/:comment_id", taskHandler.DeleteComment)
+	tasks.GET("/:id/attachments", taskHandler.ListAttachments)
+	tasks.POST("/:id/attachments", taskHandler.CreateAttachment)
+	tasks.GET("/:id/attachments/:attachment_id", taskHandler.DownloadAttachment)
+	tasks.DELETE("/:id/attachments/:attachment_id", taskHandler.DeleteAttachment)
+	tasks.GET("/:id/dependencies", taskHandler.ListDependencies)
+	tasks.GET("/:id/dependents", taskHandler.ListDependents)
+	tasks.PUT("/:id/dependencies/:dependency_id", taskHandler.AddDependency)
+	tasks.DELETE("/:id/dependencies/:dependency_id", taskHandler.RemoveDependency)
+	tasks.GET("/:id/checklist", taskHandler.GetChecklist)
+	tasks.POST("/:id/checklist", taskHandler.AddChecklistItem)
+	tasks.PUT("/:id/checklist/order", taskHandler.ReorderChecklist)
+	tasks.POST("/:id/checklist/:item_id/check", taskHandler.CheckChecklistItem)
+	tasks.POST("/:id/checklist/:item_id/uncheck", taskHandler.UncheckChecklistItem)
+	tasks.DELETE("/:id/checklist/:item_id", taskHandler.RemoveChecklistItem)
+	tasks.PUT("/:id/tags/:tag_id", taskHandler.AddTaskTag)
+	tasks.DELETE("/:id/tags/:tag_id", taskHandler.RemoveTaskTag)
+}
+
+//nolint:ireturn // 설정에 따라 Repository 구현체를 선택합니다.
+func newRepository(cfg *config.Config) (core.Repository, error) {
+	switch cfg.Storage {
+	case config.StorageMemory:
+		if cfg.SnapshotPath == "" {
+			return fake.NewRepository(), nil
+		}
+
+		repo, err := fake.NewPersistentRepository(cfg.SnapshotPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create memory repository: %w", err)
+		}
+
+		return repo, nil
+	case config.StoragePostgres:
+		repo, err := orm.NewRepository(cfg.Database.DSN())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create postgres repository: %w", err)
+		}
+
+		return repo, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported storage backend %q", config.ErrInvalidConfig, cfg.Storage)
+	}
+}
+
+//nolint:ireturn // 설정에 따라 blob 저장소 구현체를 선택합니다.
+func newBlobStore(cfg *config.Config) (blob.Store, error) {
+	switch cfg.Attachment.Backend {
+	case config.BlobMemory:
+		return blob.NewMemoryStore(), nil
+	case config.BlobLocal:
+		store, err := blob.NewLocalStore(cfg.Attachment.LocalPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create local blob storage: %w", err)
+		}
+
+		return store, nil
+	case config.BlobS3:
+		s3 := cfg.Attachment.S3
+
+		store, err := blob.NewS3Store(blob.S3Config{
+			Endpoint:        s3.Endpoint,
+			Region:          s3.Region,
+			Bucket:          s3.Bucket,
+			AccessKeyID:     s3.AccessKeyID,
+			SecretAccessKey: s3.SecretAccessKey,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create s3 blob storage: %w", err)
+		}
 
-	if err := router.Run(":8080"); err != nil {
-		log.Fatal("Failed to start server:", err)
+		return store, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported blob backend %q", config.ErrInvalidConfig, cfg.Attachment.Backend)
 	}
 }
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/i18n"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	requestIDHeader = "X-Request-ID"
+	actorHeader     = "X-Actor"
+	requestIDKey    = "request_id"
+	localizerKey    = "localizer"
+
+	maxRequestIDLength = 128
+	maxActorLength     = 128
+)
+
+// requestID 요청마다 ID를 부여하여 응답 헤더와 에러 응답에 포함합니다.
+// 클라이언트가 X-Request-ID를 보내면 그 값을 그대로 사용합니다.
+func requestID(ctx *gin.Context) {
+	id := ctx.GetHeader(requestIDHeader)
+	if id == "" || len(id) > maxRequestIDLength {
+		id = ulid.Make().String()
+	}
+
+	ctx.Set(requestIDKey, id)
+	ctx.Header(requestIDHeader, id)
+	ctx.Next()
+}
+
+// localize Accept-Language 헤더로 응답 언어를 협상하여 이후 핸들러가 사용할 수 있도록 저장합니다.
+func localize(catalog *i18n.Catalog) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		localizer := catalog.Negotiate(ctx.GetHeader("Accept-Language"))
+
+		ctx.Set(localizerKey, localizer)
+		ctx.Header("Content-Language", localizer.Language())
+		// 앞선 미들웨어가 설정한 Vary를 덮어쓰지 않도록 값을 추가합니다.
+		ctx.Writer.Header().Add("Vary", "Accept-Language")
+		ctx.Next()
+	}
+}
+
+// actor X-Actor 헤더 값을 요청 context에 담아 Service가 생성·수정 주체로 기록하도록 합니다.
+// 인증이 도입되기 전까지는 클라이언트가 보낸 값을 그대로 신뢰하며, 잘못된 값은 무시합니다.
+// system, anonymous처럼 Service가 스스로 기록하는 주체 이름은 흉내 낼 수 없도록 400으로 거부합니다.
+func actor(ctx *gin.Context) {
+	name := strings.TrimSpace(ctx.GetHeader(actorHeader))
+	if flow.IsReservedActor(name) {
+		respondError(ctx, fmt.Errorf("%w: %q", flow.ErrReservedActor, name))
+
+		return
+	}
+
+	if name != "" && len(name) <= maxActorLength && !strings.ContainsFunc(name, unicode.IsControl) {
+		ctx.Request = ctx.Request.WithContext(flow.WithActor(ctx.Request.Context(), name))
+	}
+
+	ctx.Next()
+}
+
+// localizerFrom localize 미들웨어가 저장한 Localizer를 반환합니다.
+func localizerFrom(ctx *gin.Context) *i18n.Localizer {
+	localizer, _ := ctx.MustGet(localizerKey).(*i18n.Localizer)
+
+	return localizer
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+const (
+	mergePatchContentType = "application/merge-patch+json"
+	jsonPatchContentType  = "application/json-patch+json"
+)
+
+// JSON Patch(RFC 6902)에서 지원하는 연산입니다.
+const (
+	patchOpAdd     = "add"
+	patchOpRemove  = "remove"
+	patchOpReplace = "replace"
+	patchOpTest    = "test"
+)
+
+// 패치로 변경할 수 있는 필드입니다.
+const (
+	patchFieldTitle       = "title"
+	patchFieldDescription = "description"
+	patchFieldPriority    = "priority"
+	patchFieldDueDate     = "due_date"
+)
+
+//nolint:gochecknoglobals // 변경할 수 없는 응답 필드 목록입니다.
+var readOnlyPatchFields = []string{
+	"id", "status", "version", "created_at", "created_by", "updated_at", "updated_by",
+	"tags", "parent_id", "project_id", "key", "rank", "progress", "blocked", "deleted_at",
+	"checklist", "checklist_progress",
+}
+
+// jsonPatchOperation JSON Patch 문서의 연산 하나입니다.
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// parseMergePatch JSON Merge Patch(RFC 7396) 문서를 TaskPatch로 변환합니다.
+// null은 필드 삭제를 뜻하므로 description은 빈 문자열, due_date는 마감일 없음이 되고,
+// 필수 필드인 title은 도메인 검증에서 거부됩니다.
+func parseMergePatch(body []byte) (*domain.TaskPatch, error) {
+	var doc map[string]json.RawMessage
+
+	err := json.Unmarshal(body, &doc)
+	if err != nil || doc == nil {
+		return nil, patchDocumentError(err)
+	}
+
+	patch := domain.NewTaskPatch()
+
+	var fields []FieldError
+
+	names := make([]string, 0, len(doc))
+	for name := range doc {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		value := doc[name]
+		if isJSONNull(value) {
+			value = nil
+		}
+
+		fieldErr, ok := setPatchField(patch, name, value)
+		if !ok {
+			fields = append(fields, fieldErr)
+		}
+	}
+
+	if len(fields) > 0 {
+		return nil, &requestError{cause: errValidationFailed, fields: fields}
+	}
+
+	return patch, nil
+}
+
+// parseJSONPatch JSON Patch(RFC 6902) 문서를 현재 Task에 순서대로 적용해 TaskPatch로 변환합니다.
+// test 연산은 앞선 연산이 반영된 값과 비교하며, 실패하면 errPatchTestFailed를 반환합니다.
+func parseJSONPatch(body []byte, task *domain.Task) (*domain.TaskPatch, error) {
+	var operations []jsonPatchOperation
+
+	err := json.Unmarshal(body, &operations)
+	if err != nil || operations == nil {
+		return nil, patchDocumentError(err)
+	}
+
+	patch := domain.NewTaskPatch()
+
+	for _, operation := range operations {
+		err := applyJSONPatchOperation(patch, task, &operation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return patch, nil
+}
+
+// applyJSONPatchOperation 연산 하나를 패치에 반영합니다.
+func applyJSONPatchOperation(patch *domain.TaskPatch, task *domain.Task, operation *jsonPatchOperation) error {
+	name := strings.TrimPrefix(operation.Path, "/")
+
+	var (
+		fieldErr FieldError
+		ok       bool
+	)
+
+	switch operation.Op {
+	case patchOpAdd, patchOpReplace:
+		value := operation.Value
+		if isJSONNull(value) {
+			value = nil
+		}
+
+		fieldErr, ok = setPatchField(patch, name, value)
+	case patchOpRemove:
+		fieldErr, ok = setPatchField(patch, name, nil)
+	case patchOpTest:
+		want, isValid := normalizePatchValue(name, operation.Value)
+		if !isValid || currentPatchValue(patch, task, name) != want {
+			return fmt.Errorf("%w: %s", errPatchTestFailed, operation.Path)
+		}
+
+		return nil
+	default:
+		fieldErr, ok = newFieldError("op", "unsupported", operation.Op), false
+	}
+
+	if !ok {
+		return &requestError{cause: errValidationFailed, fields: []FieldError{fieldErr}}
+	}
+
+	return nil
+}
+
+// setPatchField 필드 하나를 패치에 반영합니다. value가 nil이면 필드 삭제로 처리합니다.
+func setPatchField(patch *domain.TaskPatch, name string, value json.RawMessage) (FieldError, bool) {
+	if slices.Contains(readOnlyPatchFields, name) {
+		return newFieldError(name, "read_only", ""), false
+	}
+
+	text := ""
+
+	if value != nil {
+		var isString bool
+
+		text, isString = decodeString(value)
+		if !isString && isPatchableField(name) {
+			return newFieldError(name, "type", "string"), false
+		}
+	}
+
+	if !isPatchableField(name) {
+		return newFieldError(name, "unknown", ""), false
+	}
+
+	return assignPatchField(patch, name, text)
+}
+
+func assignPatchField(patch *domain.TaskPatch, name, text string) (FieldError, bool) {
+	switch name {
+	case patchFieldPriority:
+		priority, err := domain.ParseTaskPriority(text)
+		if err != nil {
+			return newFieldError(name, "oneof", "low medium high urgent"), false
+		}
+
+		patch.WithPriority(priority)
+	case patchFieldDueDate:
+		dueDate, err := parseDueDate(text)
+		if err != nil {
+			return newFieldError(name, "datetime", time.RFC3339), false
+		}
+
+		patch.WithDueDate(dueDate)
+	case patchFieldDescription:
+		patch.WithDescription(text)
+	default:
+		// title 삭제는 빈 제목으로 전달되어 도메인 검증에서 거부됩니다.
+		patch.WithTitle(text)
+	}
+
+	return FieldError{}, true //nolint:exhaustruct
+}
+
+func isPatchableField(name string) bool {
+	switch name {
+	case patchFieldTitle, patchFieldDescription, patchFieldPriority, patchFieldDueDate:
+		return true
+	default:
+		return false
+	}
+}
+
+// currentPatchValue 앞선 연산이 반영된 필드 값을 test 연산과 비교할 수 있는 문자열로 반환합니다.
+func currentPatchValue(patch *domain.TaskPatch, task *domain.Task, name string) string {
+	switch name {
+	case patchFieldTitle:
+		if title, ok := patch.Title(); ok {
+			return title
+		}
+
+		return task.Title()
+	case patchFieldDescription:
+		if description, ok := patch.Description(); ok {
+			return description
+		}
+
+		return task.Description()
+	case patchFieldPriority:
+		if priority, ok := patch.Priority(); ok {
+			return string(priority)
+		}
+
+		return string(task.Priority())
+	case patchFieldDueDate:
+		dueDate, ok := patch.DueDate()
+		if !ok {
+			dueDate = task.DueDate()
+		}
+
+		return formatDueDate(dueDate)
+	default:
+		return ""
+	}
+}
+
+// normalizePatchValue test 연산의 값을 currentPatchValue와 같은 형식으로 변환합니다.
+func normalizePatchValue(name string, value json.RawMessage) (string, bool) {
+	if isJSONNull(value) {
+		return "", true
+	}
+
+	text, ok := decodeString(value)
+	if !ok || name != patchFieldDueDate {
+		return text, ok
+	}
+
+	dueDate, err := parseDueDate(text)
+	if err != nil {
+		return "", false
+	}
+
+	return formatDueDate(dueDate), true
+}
+
+// parseDueDate RFC 3339 형식의 마감일을 해석합니다. 빈 문자열은 마감일 없음입니다.
+func parseDueDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	dueDate, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse due date: %w", err)
+	}
+
+	return &dueDate, nil
+}
+
+func formatDueDate(dueDate *time.Time) string {
+	if dueDate == nil {
+		return ""
+	}
+
+	return dueDate.UTC().Format(time.RFC3339Nano)
+}
+
+func decodeString(value json.RawMessage) (string, bool) {
+	var text string
+
+	err := json.Unmarshal(value, &text)
+	if err != nil || isJSONNull(value) {
+		return "", false
+	}
+
+	return text, true
+}
+
+func isJSONNull(value json.RawMessage) bool {
+	return strings.TrimSpace(string(value)) == "null"
+}
+
+func patchDocumentError(err error) error {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return &requestError{cause: fmt.Errorf("%w: %w", errMalformedJSON, err), fields: nil}
+	}
+
+	return &requestError{cause: errInvalidPatch, fields: nil}
+}
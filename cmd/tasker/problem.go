@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	problemTypePrefix  = "urn:tasker:error:"
+)
+
+// Problem RFC 7807 형식의 에러 응답입니다.
+type Problem struct {
+	Type      string       `json:"type" example:"urn:tasker:error:task_not_found"`
+	Title     string       `json:"title" example:"Task not found"`
+	Status    int          `json:"status" example:"404"`
+	Detail    string       `json:"detail,omitempty" example:"No task exists with the given ID."`
+	Instance  string       `json:"instance,omitempty" example:"/tasker/v1/tasks/01JZ3Q5V9X2M8K7N4P6R0S1T2U"`
+	Code      string       `json:"code" example:"task_not_found" enums:"missing_id,invalid_request,validation_failed,invalid_status,invalid_action,invalid_priority,invalid_query,invalid_cursor,route_not_found,task_not_found,parent_not_found,parent_cycle,dependency_task_not_found,dependency_not_found,dependency_cycle,invalid_revision,revision_not_found,parent_comment_not_found,comment_not_found,comment_forbidden,attachment_not_found,attachment_too_large,checklist_item_not_found,project_not_found,task_project_not_found,duplicate_project,project_not_empty,project_conflict,task_not_on_board,column_not_found,position_task_not_found,tag_not_found,duplicate_tag,conflict,invalid_transition,precondition_failed,patch_test_failed,unsupported_media_type,timeout,request_cancelled,reserved_actor,internal_error"` //nolint:lll
+	RequestID string       `json:"request_id,omitempty" example:"01JZ3Q5V9X2M8K7N4P6R0S1T2U"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError 요청 본문의 필드별 검증 실패 정보입니다.
+type FieldError struct {
+	Field   string `json:"field" example:"title"`
+	Code    string `json:"code" example:"required"`
+	Message string `json:"message" example:"title is required"`
+
+	// param 지역화된 메시지에 사용할 추가 인자입니다 (예: 기대하는 타입).
+	param string
+}
+
+// requestError 요청 본문을 해석하거나 검증하지 못했을 때의 에러로, errInvalidRequest로 분류됩니다.
+type requestError struct {
+	cause  error
+	fields []FieldError
+}
+
+func (e *requestError) Error() string {
+	return fmt.Sprintf("%s: %s", errInvalidRequest, e.cause)
+}
+
+func (e *requestError) Unwrap() []error {
+	return []error{errInvalidRequest, e.cause}
+}
+
+// bindJSON 요청 본문을 req에 바인딩하고, 실패하면 필드별 상세 정보를 담은 requestError를 반환합니다.
+func bindJSON(ctx *gin.Context, req any) error {
+	err := ctx.ShouldBindJSON(req)
+	if err == nil {
+		return nil
+	}
+
+	reqErr := &requestError{cause: err, fields: nil}
+
+	var (
+		validationErrs validator.ValidationErrors
+		typeErr        *json.UnmarshalTypeError
+		syntaxErr      *json.SyntaxError
+	)
+
+	switch {
+	case errors.As(err, &validationErrs):
+		reqErr.cause = errValidationFailed
+
+		for _, fieldErr := range validationErrs {
+			reqErr.fields = append(reqErr.fields, newFieldError(fieldErr.Field(), fieldErr.Tag(), fieldErr.Param()))
+		}
+	case errors.As(err, &typeErr):
+		reqErr.cause = fmt.Errorf("%w: %s", errInvalidFieldType, typeErr.Field)
+		reqErr.fields = append(reqErr.fields, newFieldError(typeErr.Field, "type", typeErr.Type.Kind().String()))
+	case errors.As(err, &syntaxErr):
+		reqErr.cause = fmt.Errorf("%w at offset %d", errMalformedJSON, syntaxErr.Offset)
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		reqErr.cause = errMalformedJSON
+	}
+
+	return reqErr
+}
+
+// newFieldError 메시지는 응답 시 요청 언어로 채워집니다.
+func newFieldError(field, code, param string) FieldError {
+	return FieldError{Field: field, Code: code, Message: "", param: param}
+}
+
+//nolint:gochecknoglobals // 검증기 설정은 프로세스 전체에서 한 번만 적용합니다.
+var registerJSONFieldNamesOnce sync.Once
+
+// registerJSONFieldNames 검증 에러의 필드 이름을 Go 필드 이름 대신 JSON 이름으로 표시하도록 설정합니다.
+func registerJSONFieldNames() {
+	registerJSONFieldNamesOnce.Do(func() {
+		validate, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+
+		validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+
+			return name
+		})
+	})
+}
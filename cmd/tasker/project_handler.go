@@ -0,0 +1,349 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+type CreateProjectRequest struct {
+	// Key Task 키의 접두사입니다. 소문자로 보내도 대문자로 저장되며 생성한 뒤에는 바꿀 수 없습니다.
+	Key         string `json:"key" example:"OPS"`
+	Name        string `json:"name" example:"운영"`
+	Description string `json:"description" example:"운영 업무"`
+	// DefaultPriority 우선순위를 지정하지 않고 프로젝트에 만든 Task의 우선순위입니다. 비어 있으면 medium입니다.
+	DefaultPriority string `json:"default_priority" example:"high" enums:"low,medium,high,urgent"`
+}
+
+type UpdateProjectRequest struct {
+	Name            string `json:"name" example:"운영"`
+	Description     string `json:"description" example:"운영 업무"`
+	DefaultPriority string `json:"default_priority" example:"high" enums:"low,medium,high,urgent"`
+}
+
+type ProjectResponse struct {
+	ID              string `json:"id" example:"01JZ3R"`
+	Key             string `json:"key" example:"OPS"`
+	Name            string `json:"name" example:"운영"`
+	Description     string `json:"description" example:"운영 업무"`
+	DefaultPriority string `json:"default_priority" example:"high"`
+	// Columns 보드의 열을 순서대로 담습니다. 설정하지 않았으면 상태마다 열이 하나씩 있습니다.
+	Columns   []*BoardColumnResponse `json:"columns"`
+	Version   int                    `json:"version" example:"1"`
+	CreatedAt time.Time              `json:"created_at" example:"2026-01-02T03:04:05.123456Z"`
+	CreatedBy string                 `json:"created_by" example:"alice"`
+	UpdatedAt time.Time              `json:"updated_at" example:"2026-01-02T03:04:05.123456Z"`
+	UpdatedBy string                 `json:"updated_by" example:"alice"`
+}
+
+type ProjectListResponse struct {
+	Projects []*ProjectResponse `json:"projects"`
+}
+
+func newProjectResponse(project *domain.Project) *ProjectResponse {
+	return &ProjectResponse{
+		ID:              string(project.ID()),
+		Key:             string(project.Key()),
+		Name:            project.Name(),
+		Description:     project.Description(),
+		DefaultPriority: string(project.DefaultPriority()),
+		Columns:         newBoardColumnResponses(project.Columns()),
+		Version:         project.Version(),
+		CreatedAt:       project.Created().At(),
+		CreatedBy:       project.Created().By(),
+		UpdatedAt:       project.Updated().At(),
+		UpdatedBy:       project.Updated().By(),
+	}
+}
+
+// respondProject 프로젝트를 버전 ETag와 함께 응답합니다.
+func respondProject(ctx *gin.Context, status int, project *domain.Project) {
+	setProjectETag(ctx, project)
+	ctx.JSON(status, newProjectResponse(project))
+}
+
+// CreateProject 새로운 프로젝트 생성
+// @Summary Create a new project
+// @Description 새로운 프로젝트를 생성합니다. 키는 영문자로 시작하는 2-10자의 영문자와 숫자이며 대문자로 저장되고,
+// @Description 이름은 앞뒤 공백을 제외하고 1-100자입니다. 위반 시 422를, 같은 키의 프로젝트가 있으면 409를 반환합니다
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param project body CreateProjectRequest true "Project information"
+// @Success 201 {object} ProjectResponse
+// @Header 201 {string} ETag "Project version"
+// @Failure 400 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /projects [post]
+func (h *Handler) CreateProject(ctx *gin.Context) {
+	var req CreateProjectRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	key, err := domain.ParseProjectKey(req.Key)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	spec, err := newProjectSpec(req.Name, req.Description, req.DefaultPriority)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	project, err := h.service.CreateProject(ctx, key, spec)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	respondProject(ctx, http.StatusCreated, project)
+}
+
+// ListProjects 프로젝트 목록 조회
+// @Summary List projects
+// @Description 모든 프로젝트를 키 순서로 조회합니다
+// @Tags projects
+// @Produce json
+// @Success 200 {object} ProjectListResponse
+// @Failure 500 {object} Problem
+// @Router /projects [get]
+func (h *Handler) ListProjects(ctx *gin.Context) {
+	projects, err := h.service.ListProjects(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ret := make([]*ProjectResponse, len(projects))
+	for i, project := range projects {
+		ret[i] = newProjectResponse(project)
+	}
+
+	ctx.JSON(http.StatusOK, &ProjectListResponse{Projects: ret})
+}
+
+// GetProject ID로 특정 프로젝트 조회
+// @Summary Get project
+// @Description ID로 특정 프로젝트를 조회합니다
+// @Tags projects
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} ProjectResponse
+// @Header 200 {string} ETag "Project version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /projects/{id} [get]
+func (h *Handler) GetProject(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingProjectID)
+
+		return
+	}
+
+	project, err := h.service.GetProject(ctx, domain.ProjectID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	respondProject(ctx, http.StatusOK, project)
+}
+
+// UpdateProject 프로젝트 수정
+// @Summary Update project
+// @Description 프로젝트의 이름, 설명, 기본 우선순위를 수정합니다. 키는 바꿀 수 없으며,
+// @Description 바뀐 기본 우선순위는 이후에 만드는 Task에만 적용됩니다
+// @Description If-Match 헤더가 현재 ETag와 다르면 412를, 동시 수정이 감지되면 409를 반환합니다
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Param project body UpdateProjectRequest true "Updated project information"
+// @Success 200 {object} ProjectResponse
+// @Header 200 {string} ETag "Project version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /projects/{id} [put]
+func (h *Handler) UpdateProject(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingProjectID)
+
+		return
+	}
+
+	var req UpdateProjectRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	version, err := ifMatchVersion(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	spec, err := newProjectSpec(req.Name, req.Description, req.DefaultPriority)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	project, err := h.service.UpdateProject(ctx, domain.ProjectID(id), spec, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	respondProject(ctx, http.StatusOK, project)
+}
+
+// DeleteProject 프로젝트 삭제
+// @Summary Delete project
+// @Description 프로젝트를 삭제합니다. 휴지통에 있는 것을 포함하여 프로젝트에 속한 Task가 있으면 409를 반환합니다
+// @Tags projects
+// @Param id path string true "Project ID"
+// @Success 204
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /projects/{id} [delete]
+func (h *Handler) DeleteProject(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingProjectID)
+
+		return
+	}
+
+	err := h.service.DeleteProject(ctx, domain.ProjectID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListProjectTasks 프로젝트의 Task 목록 조회
+// @Summary List project tasks
+// @Description 프로젝트에 속한 Task 목록을 조회합니다. 페이지네이션, 정렬, 필터는 Task 목록 조회와 같습니다
+// @Tags projects
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort field, '-' prefix for descending"
+// @Param status query []string false "Filter by status" collectionFormat(multi)
+// @Param priority query []string false "Filter by priority" collectionFormat(multi)
+// @Param tag query []string false "Filter by tag name" collectionFormat(multi)
+// @Param tag_match query string false "Match any (default) or all of the given tags" Enums(any, all)
+// @Param q query string false "Filter by title substring (case-insensitive)"
+// @Param due_before query string false "Only tasks due before this RFC 3339 date-time"
+// @Param due_after query string false "Only tasks due at or after this RFC 3339 date-time"
+// @Success 200 {object} TaskListResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /projects/{id}/tasks [get]
+func (h *Handler) ListProjectTasks(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingProjectID)
+
+		return
+	}
+
+	query, err := newListTasksQuery(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	page, err := h.service.ListProjectTasks(ctx, domain.ProjectID(id), query)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTaskPage(ctx, page)
+}
+
+// GetTaskByKey 키로 특정 Task 조회
+// @Summary Get task by key
+// @Description 프로젝트 키와 번호로 이루어진 키(예: OPS-42)로 Task를 조회합니다. 대소문자를 구분하지 않습니다
+// @Tags tasks
+// @Produce json
+// @Param key path string true "Task key"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/by-key/{key} [get]
+func (h *Handler) GetTaskByKey(ctx *gin.Context) {
+	key := ctx.Param("key")
+	if key == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	task, err := h.service.GetTaskByKey(ctx, key)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+func newProjectSpec(name, description, defaultPriority string) (*domain.ProjectSpec, error) {
+	var priority domain.TaskPriority
+
+	if defaultPriority != "" {
+		parsed, err := domain.ParseTaskPriority(defaultPriority)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		priority = parsed
+	}
+
+	return domain.NewProjectSpec(domain.ProjectSpecParams{ //nolint:wrapcheck
+		Name:            name,
+		Description:     description,
+		DefaultPriority: priority,
+	})
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/config"
+)
+
+// startTrashRetention 보관 기간이 설정되어 있으면 runTrashRetention을 goroutine으로 실행합니다.
+// 종료할 때 진행 중인 영구 삭제를 기다릴 수 있도록 goroutine을 background에 등록합니다.
+func startTrashRetention(
+	ctx context.Context,
+	background *sync.WaitGroup,
+	service *flow.Service,
+	trash config.TrashConfig,
+) {
+	if trash.Retention <= 0 {
+		return
+	}
+
+	background.Add(1)
+
+	go func() {
+		defer background.Done()
+
+		runTrashRetention(ctx, service, trash)
+	}()
+}
+
+// runTrashRetention 시작할 때와 PurgeInterval마다 보관 기간이 지난 휴지통의 Task를 영구 삭제합니다.
+// ctx가 취소될 때까지 반환하지 않으므로 별도의 goroutine에서 실행해야 합니다.
+func runTrashRetention(ctx context.Context, service *flow.Service, trash config.TrashConfig) {
+	ctx = flow.WithActor(ctx, flow.SystemActor)
+
+	ticker := time.NewTicker(trash.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purgeExpiredTasks(ctx, service, trash.Retention)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeExpiredTasks 한 번 영구 삭제를 실행합니다. 실패해도 다음 주기에 다시 시도하므로 로그만 남깁니다.
+func purgeExpiredTasks(ctx context.Context, service *flow.Service, retention time.Duration) {
+	purged, err := service.PurgeExpiredTasks(ctx, retention)
+	if err != nil {
+		log.Printf("Failed to purge expired tasks: %v", err)
+
+		return
+	}
+
+	if len(purged) > 0 {
+		log.Printf("Purged %d task(s) deleted more than %s ago", len(purged), retention)
+	}
+}
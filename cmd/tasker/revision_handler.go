@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+// RevisionResponse Task 버전 하나의 스냅샷입니다. 태그는 그 버전이 저장될 당시의 값입니다.
+type RevisionResponse struct {
+	Revision    int            `json:"revision" example:"3"`
+	Title       string         `json:"title" example:"새로운 작업"`
+	Description string         `json:"description" example:"작업 설명"`
+	Status      string         `json:"status" example:"open"`
+	Priority    string         `json:"priority" example:"high"`
+	DueDate     *time.Time     `json:"due_date" example:"2026-01-31T09:00:00Z"`
+	ParentID    string         `json:"parent_id,omitempty" example:"01JZ3Q"`
+	Tags        []*TagResponse `json:"tags"`
+	UpdatedAt   time.Time      `json:"updated_at" example:"2026-01-02T03:04:05.123456Z"`
+	UpdatedBy   string         `json:"updated_by" example:"alice"`
+}
+
+type RevisionListResponse struct {
+	Revisions []*RevisionResponse `json:"revisions"`
+}
+
+type RevisionDiffResponse struct {
+	TaskID  string                 `json:"task_id" example:"01JZ3Q"`
+	From    int                    `json:"from" example:"1"`
+	To      int                    `json:"to" example:"3"`
+	Changes []*FieldChangeResponse `json:"changes"`
+}
+
+func newRevisionResponse(revision *domain.Task) *RevisionResponse {
+	return &RevisionResponse{
+		Revision:    revision.Version(),
+		Title:       revision.Title(),
+		Description: revision.Description(),
+		Status:      string(revision.Status()),
+		Priority:    string(revision.Priority()),
+		DueDate:     revision.DueDate(),
+		ParentID:    string(revision.ParentID()),
+		Tags:        newTagResponses(revision.Tags()),
+		UpdatedAt:   revision.Updated().At(),
+		UpdatedBy:   revision.Updated().By(),
+	}
+}
+
+func newRevisionDiffResponse(diff domain.RevisionDiff) *RevisionDiffResponse {
+	return &RevisionDiffResponse{
+		TaskID:  string(diff.TaskID()),
+		From:    diff.From(),
+		To:      diff.To(),
+		Changes: newFieldChangeResponses(diff.Changes()),
+	}
+}
+
+// ListRevisions Task 리비전 목록 조회
+// @Summary List task revisions
+// @Description Task가 저장될 때마다 남는 버전별 스냅샷을 버전 순서로 조회합니다. revision은 그 스냅샷의 Task 버전입니다
+// @Tags revisions
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} RevisionListResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/revisions [get]
+func (h *Handler) ListRevisions(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	revisions, err := h.service.ListTaskRevisions(ctx, domain.TaskID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	responses := make([]*RevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		responses[i] = newRevisionResponse(revision)
+	}
+
+	ctx.JSON(http.StatusOK, &RevisionListResponse{Revisions: responses})
+}
+
+// GetRevision Task 리비전 조회
+// @Summary Get task revision
+// @Description Task의 특정 버전의 스냅샷을 조회합니다
+// @Tags revisions
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param rev path int true "Revision (task version)"
+// @Success 200 {object} RevisionResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/revisions/{rev} [get]
+func (h *Handler) GetRevision(ctx *gin.Context) {
+	id, rev, err := revisionParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	revision, err := h.service.GetTaskRevision(ctx, id, rev)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newRevisionResponse(revision))
+}
+
+// DiffRevisions 두 리비전 비교
+// @Summary Diff task revisions
+// @Description rev 리비전에서 to 리비전으로 바뀐 필드를 조회합니다. to를 생략하면 현재 버전과 비교합니다
+// @Description 시각은 UTC RFC 3339 형식이며, 태그는 쉼표로 구분된 이름입니다
+// @Tags revisions
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param rev path int true "Revision to diff from"
+// @Param to query int false "Revision to diff to (default current version)"
+// @Success 200 {object} RevisionDiffResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/revisions/{rev}/diff [get]
+func (h *Handler) DiffRevisions(ctx *gin.Context) {
+	id, rev, err := revisionParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	to, err := queryRevision(ctx, "to")
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	diff, err := h.service.DiffTaskRevisions(ctx, id, rev, to)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newRevisionDiffResponse(diff))
+}
+
+// RevertTask Task를 리비전으로 되돌리기
+// @Summary Revert task to revision
+// @Description Task의 제목, 설명, 우선순위, 마감일을 rev 리비전의 값으로 되돌려 새 버전으로 저장합니다
+// @Description 상태와 상위 Task, 태그는 바뀌지 않으며, 바뀌는 것이 없으면 Task를 수정하지 않고 그대로 반환합니다
+// @Tags revisions
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param rev path int true "Revision to revert to"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/revisions/{rev}/revert [post]
+func (h *Handler) RevertTask(ctx *gin.Context) {
+	id, rev, err := revisionParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	version, err := ifMatchVersion(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.RevertTask(ctx, id, rev, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// revisionParams 경로의 Task ID와 리비전 번호를 읽습니다.
+func revisionParams(ctx *gin.Context) (domain.TaskID, int, error) {
+	id := ctx.Param("id")
+	if id == "" {
+		return "", 0, errMissingTaskID
+	}
+
+	rev, err := strconv.Atoi(ctx.Param("rev"))
+	if err != nil || rev < 1 {
+		return "", 0, fmt.Errorf("%w: %q", errInvalidRevision, ctx.Param("rev"))
+	}
+
+	return domain.TaskID(id), rev, nil
+}
+
+// queryRevision 리비전 번호 쿼리 파라미터를 읽습니다. 없으면 0입니다.
+func queryRevision(ctx *gin.Context, key string) (int, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+
+	rev, err := strconv.Atoi(value)
+	if err != nil || rev < 1 {
+		return 0, fmt.Errorf("%w: %s must be a positive integer", core.ErrInvalidQuery, key)
+	}
+
+	return rev, nil
+}
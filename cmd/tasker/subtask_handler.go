@@ -0,0 +1,153 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+type MoveTaskRequest struct {
+	// ParentID 생략하면 상위 Task를 바꾸지 않으며, 빈 문자열이면 최상위 Task가 됩니다.
+	ParentID *string `json:"parent_id" example:"01JZ3Q"`
+	// Column 옮길 보드 열의 키입니다. column이나 after_id를 보내면 프로젝트 보드에서 옮기며,
+	// 생략하면 지금 놓인 열 안에서 순서만 바꿉니다.
+	Column string `json:"column" example:"in_progress"`
+	// AfterID 열에서 바로 앞에 놓일 Task의 ID입니다. 비어 있으면 열의 맨 앞으로 옮깁니다.
+	AfterID *string `json:"after_id" example:"01JZ3R"`
+}
+
+type ProgressResponse struct {
+	Total     int `json:"total" example:"4"`
+	Completed int `json:"completed" example:"1"`
+	Percent   int `json:"percent" example:"25"`
+}
+
+func newProgressResponse(progress domain.Progress) *ProgressResponse {
+	return &ProgressResponse{
+		Total:     progress.Total(),
+		Completed: progress.Completed(),
+		Percent:   progress.Percent(),
+	}
+}
+
+// ListSubtasks 하위 Task 목록 조회
+// @Summary List subtasks
+// @Description Task의 바로 아래 하위 Task 목록을 조회합니다. 페이지네이션, 정렬, 필터는 Task 목록 조회와 같습니다
+// @Tags tasks
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort field, '-' prefix for descending"
+// @Param status query []string false "Filter by status" collectionFormat(multi)
+// @Param priority query []string false "Filter by priority" collectionFormat(multi)
+// @Param tag query []string false "Filter by tag name" collectionFormat(multi)
+// @Param tag_match query string false "Match any (default) or all of the given tags" Enums(any, all)
+// @Param q query string false "Filter by title substring (case-insensitive)"
+// @Success 200 {object} TaskListResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/children [get]
+func (h *Handler) ListSubtasks(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	query, err := newListTasksQuery(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	page, err := h.service.ListSubtasks(ctx, domain.TaskID(id), query)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTaskPage(ctx, page)
+}
+
+// MoveTask Task를 다른 상위 Task 아래나 보드의 다른 위치로 이동
+// @Summary Move task
+// @Description Task를 parent_id 아래로 옮깁니다. parent_id가 빈 문자열이면 최상위 Task가 되고, 생략하면 상위 Task는 그대로입니다
+// @Description 상위 Task가 없으면 422를, 자기 자신이나 자신의 하위 Task 아래로 옮기면 409를 반환합니다.
+// @Description column이나 after_id를 보내면 프로젝트 보드의 column 열에서 after_id 바로 뒤로 옮기며,
+// @Description 다른 열로 옮기면 상태가 그 열의 상태 중 전이할 수 있는 첫 번째 상태로 바뀌며, 전이할 수 없으면 409를 반환합니다.
+// @Description 같은 열의 다른 Task는 바뀌지 않습니다.
+// @Description 프로젝트에 속하지 않은 Task이면 409를, 열이나 after_id의 Task가 열에 없으면 422를 반환합니다
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Param move body MoveTaskRequest true "New parent or board position"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/move [post]
+func (h *Handler) MoveTask(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	var req MoveTaskRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	version, err := ifMatchVersion(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.MoveTask(ctx, domain.TaskID(id), newTaskMove(&req), version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// newTaskMove 요청을 flow.TaskMove로 변환합니다. column이나 after_id를 보낸 요청만 보드에서 옮깁니다.
+func newTaskMove(req *MoveTaskRequest) flow.TaskMove {
+	move := flow.TaskMove{
+		ParentID: nil,
+		Board:    req.Column != "" || req.AfterID != nil,
+		Column:   req.Column,
+		AfterID:  "",
+	}
+
+	if req.ParentID != nil {
+		parentID := domain.TaskID(*req.ParentID)
+		move.ParentID = &parentID
+	}
+
+	if req.AfterID != nil {
+		move.AfterID = domain.TaskID(*req.AfterID)
+	}
+
+	return move
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+type TagRequest struct {
+	Name  string `json:"name" example:"work"`
+	Color string `json:"color" example:"#3366ff"`
+}
+
+type TagResponse struct {
+	ID    string `json:"id" example:"01JZ3Q"`
+	Name  string `json:"name" example:"work"`
+	Color string `json:"color" example:"#3366ff"`
+}
+
+type TagListResponse struct {
+	Tags []*TagResponse `json:"tags"`
+}
+
+func newTagResponse(tag *domain.Tag) *TagResponse {
+	return &TagResponse{
+		ID:    string(tag.ID()),
+		Name:  tag.Name(),
+		Color: tag.Color(),
+	}
+}
+
+func newTagResponses(tags []*domain.Tag) []*TagResponse {
+	ret := make([]*TagResponse, len(tags))
+	for i, tag := range tags {
+		ret[i] = newTagResponse(tag)
+	}
+
+	return ret
+}
+
+// CreateTag 새로운 태그 생성
+// @Summary Create a new tag
+// @Description 새로운 태그를 생성합니다. 이름은 소문자로 저장되며 문자, 숫자, '-', '_'로 50자 이하여야 합니다
+// @Description 색상은 비어 있거나 #rrggbb 형식이어야 하며, 같은 이름의 태그가 있으면 409를 반환합니다
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param tag body TagRequest true "Tag information"
+// @Success 201 {object} TagResponse
+// @Failure 400 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tags [post]
+func (h *Handler) CreateTag(ctx *gin.Context) {
+	var req TagRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	spec, err := domain.NewTagSpec(req.Name, req.Color)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	tag, err := h.service.CreateTag(ctx, spec)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newTagResponse(tag))
+}
+
+// ListTags 태그 목록 조회
+// @Summary List tags
+// @Description 모든 태그를 이름 순서로 조회합니다
+// @Tags tags
+// @Produce json
+// @Success 200 {object} TagListResponse
+// @Failure 500 {object} Problem
+// @Router /tags [get]
+func (h *Handler) ListTags(ctx *gin.Context) {
+	tags, err := h.service.ListTags(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &TagListResponse{Tags: newTagResponses(tags)})
+}
+
+// GetTag ID로 특정 태그 조회
+// @Summary Get tag
+// @Description ID로 특정 태그를 조회합니다
+// @Tags tags
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Success 200 {object} TagResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tags/{id} [get]
+func (h *Handler) GetTag(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTagID)
+
+		return
+	}
+
+	tag, err := h.service.GetTag(ctx, domain.TagID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newTagResponse(tag))
+}
+
+// UpdateTag 태그 수정
+// @Summary Update tag
+// @Description 태그의 이름과 색상을 수정합니다. 태그가 붙은 Task에도 바로 반영되며 그 Task의 버전과 ETag가 바뀝니다
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Param tag body TagRequest true "Updated tag information"
+// @Success 200 {object} TagResponse
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tags/{id} [put]
+func (h *Handler) UpdateTag(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTagID)
+
+		return
+	}
+
+	var req TagRequest
+	if err := bindJSON(ctx, &req); err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	spec, err := domain.NewTagSpec(req.Name, req.Color)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	tag, err := h.service.UpdateTag(ctx, domain.TagID(id), spec)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newTagResponse(tag))
+}
+
+// DeleteTag 태그 삭제
+// @Summary Delete tag
+// @Description 태그를 삭제하고 태그가 붙은 모든 Task에서 뗍니다. 태그가 붙어 있던 Task의 버전과 ETag가 바뀝니다
+// @Tags tags
+// @Param id path string true "Tag ID"
+// @Success 204
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tags/{id} [delete]
+func (h *Handler) DeleteTag(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTagID)
+
+		return
+	}
+
+	err := h.service.DeleteTag(ctx, domain.TagID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// AddTaskTag Task에 태그 붙이기
+// @Summary Add tag to task
+// @Description Task에 태그를 붙입니다. 이미 붙어 있으면 Task를 수정하지 않고 그대로 반환합니다
+// @Tags tasks
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param tag_id path string true "Tag ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/tags/{tag_id} [put]
+func (h *Handler) AddTaskTag(ctx *gin.Context) {
+	id, tagID, version, err := taskTagParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.AddTaskTag(ctx, id, tagID, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// RemoveTaskTag Task에서 태그 떼기
+// @Summary Remove tag from task
+// @Description Task에서 태그를 뗍니다. 붙어 있지 않으면 Task를 수정하지 않고 그대로 반환합니다
+// @Tags tasks
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param tag_id path string true "Tag ID"
+// @Param If-Match header string false "ETag returned by a previous read"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 409 {object} Problem
+// @Failure 412 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/tags/{tag_id} [delete]
+func (h *Handler) RemoveTaskTag(ctx *gin.Context) {
+	id, tagID, version, err := taskTagParams(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	task, err := h.service.RemoveTaskTag(ctx, id, tagID, version)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// taskTagParams 경로의 Task ID와 태그 ID, If-Match 버전을 읽습니다.
+func taskTagParams(ctx *gin.Context) (domain.TaskID, domain.TagID, int, error) {
+	id := ctx.Param("id")
+	if id == "" {
+		return "", "", 0, errMissingTaskID
+	}
+
+	tagID := ctx.Param("tag_id")
+	if tagID == "" {
+		return "", "", 0, errMissingTagID
+	}
+
+	version, err := ifMatchVersion(ctx)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	return domain.TaskID(id), domain.TagID(tagID), version, nil
+}
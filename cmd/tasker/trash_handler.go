@@ -0,0 +1,104 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+// ListTrash 휴지통의 Task 목록 조회
+// @Summary List deleted tasks
+// @Description 휴지통에 있는 Task 목록을 조회합니다. 페이지네이션, 정렬, 필터는 Task 목록 조회와 같습니다
+// @Tags trash
+// @Produce json
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort field, '-' prefix for descending"
+// @Param status query []string false "Filter by status" collectionFormat(multi)
+// @Param priority query []string false "Filter by priority" collectionFormat(multi)
+// @Param tag query []string false "Filter by tag name" collectionFormat(multi)
+// @Param tag_match query string false "Match any (default) or all of the given tags" Enums(any, all)
+// @Param q query string false "Filter by title substring (case-insensitive)"
+// @Success 200 {object} TaskListResponse
+// @Failure 400 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/trash [get]
+func (h *Handler) ListTrash(ctx *gin.Context) {
+	query, err := newListTasksQuery(ctx)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	page, err := h.service.ListDeletedTasks(ctx, query)
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTaskPage(ctx, page)
+}
+
+// RestoreTask 휴지통의 Task 복원
+// @Summary Restore task
+// @Description 휴지통에 있는 Task를 복원합니다. 태그와 의존성도 함께 돌아오며 버전은 바뀌지 않습니다
+// @Description 상위 Task가 휴지통에 있으면 422를 반환하므로 상위 Task를 먼저 복원해야 합니다
+// @Tags trash
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} TaskResponse
+// @Header 200 {string} ETag "Task version"
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 422 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/{id}/restore [post]
+func (h *Handler) RestoreTask(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	task, err := h.service.RestoreTask(ctx, domain.TaskID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	h.respondTask(ctx, http.StatusOK, task)
+}
+
+// PurgeTask 휴지통의 Task 영구 삭제
+// @Summary Purge task
+// @Description 휴지통에 있는 Task를 영구 삭제합니다. 태그 연결과 의존성도 함께 삭제되고 하위 Task는 최상위 Task가 되며 되돌릴 수 없습니다
+// @Description 첨부 파일을 먼저 삭제하므로, 도중에 실패해도 Task는 휴지통에 남아 다시 요청할 수 있습니다
+// @Tags trash
+// @Param id path string true "Task ID"
+// @Success 204
+// @Failure 400 {object} Problem
+// @Failure 404 {object} Problem
+// @Failure 500 {object} Problem
+// @Router /tasks/trash/{id} [delete]
+func (h *Handler) PurgeTask(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		respondError(ctx, errMissingTaskID)
+
+		return
+	}
+
+	err := h.service.PurgeTask(ctx, domain.TaskID(id))
+	if err != nil {
+		respondError(ctx, err)
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,35 @@
+package flow
+
+import (
+	"context"
+	"strings"
+)
+
+// AnonymousActor 요청에 주체 정보가 없을 때 기록되는 주체입니다.
+const AnonymousActor = "anonymous"
+
+// SystemActor 요청이 아닌 백그라운드 작업이 변경한 것으로 기록되는 주체입니다.
+const SystemActor = "system"
+
+type actorKey struct{}
+
+// IsReservedActor name이 Service가 스스로 기록하는 주체와 같은지 확인합니다. 대소문자는 구분하지 않습니다.
+// 요청이 이 이름을 주체로 사용하면 백그라운드 작업이나 익명 요청의 변경과 구별할 수 없습니다.
+func IsReservedActor(name string) bool {
+	return strings.EqualFold(name, SystemActor) || strings.EqualFold(name, AnonymousActor)
+}
+
+// WithActor 이후 Service 호출에서 생성·수정 주체로 기록될 actor를 ctx에 담습니다.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom ctx에 담긴 actor를 반환합니다. 없으면 AnonymousActor입니다.
+func ActorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	if actor == "" {
+		return AnonymousActor
+	}
+
+	return actor
+}
@@ -0,0 +1,195 @@
+package flow
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+)
+
+// sniffLength http.DetectContentType이 MIME 타입을 판별할 때 보는 최대 바이트 수입니다.
+const sniffLength = 512
+
+// purgeAttachmentBatchSize 영구 삭제된 Task에 남은 첨부 파일을 한 번에 읽는 수입니다.
+const purgeAttachmentBatchSize = 100
+
+// MaxAttachmentSize 첨부 파일의 최대 크기(바이트)입니다.
+func (s *Service) MaxAttachmentSize() int64 {
+	return s.maxAttachmentSize
+}
+
+// ListAttachments Task의 첨부 파일을 업로드 순서로 조회합니다.
+func (s *Service) ListAttachments(ctx context.Context, taskID domain.TaskID) ([]*domain.Attachment, error) {
+	_, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.repo.ListAttachments(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// CreateAttachment body에서 size바이트를 읽어 Task에 첨부합니다. size가 최대 크기를 넘으면 ErrAttachmentTooLarge를 반환합니다.
+// MIME 타입은 클라이언트가 보낸 값을 믿지 않고 본문의 앞부분으로 판별합니다.
+// 본문을 blob 저장소에 먼저 저장하고, 메타데이터 저장에 실패하면 저장한 본문을 지웁니다.
+func (s *Service) CreateAttachment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	spec *domain.AttachmentSpec,
+	body io.Reader,
+	size int64,
+) (*domain.Attachment, error) {
+	_, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if size > s.maxAttachmentSize {
+		return nil, fmt.Errorf("%w: %d bytes exceeds %d bytes", ErrAttachmentTooLarge, size, s.maxAttachmentSize)
+	}
+
+	reader := bufio.NewReaderSize(body, sniffLength)
+
+	head, err := reader.Peek(sniffLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+
+	hash := sha256.New()
+	blob := domain.AttachmentBlob{
+		Key:         path.Join("tasks", string(taskID), ulid.Make().String()),
+		ContentType: http.DetectContentType(head),
+		Size:        size,
+		Checksum:    "",
+	}
+
+	err = s.blobs.Put(ctx, blob.Key, io.TeeReader(reader, hash), size, blob.ContentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	blob.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	attachment, err := s.repo.CreateAttachment(ctx, taskID, spec, blob, s.stamp(ctx))
+	if err != nil {
+		// 요청이 취소되었어도 저장한 본문은 지워야 하므로 취소되지 않는 context를 사용합니다.
+		_ = s.blobs.Delete(context.WithoutCancel(ctx), blob.Key)
+
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// OpenAttachment 첨부 파일의 메타데이터와 본문을 읽습니다. 호출자가 본문을 닫아야 합니다.
+func (s *Service) OpenAttachment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	id domain.AttachmentID,
+) (*domain.Attachment, io.ReadCloser, error) {
+	attachment, err := s.getTaskAttachment(ctx, taskID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := s.blobs.Get(ctx, attachment.Blob().Key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open attachment: %w", err)
+	}
+
+	return attachment, body, nil
+}
+
+// DeleteAttachment 첨부 파일의 메타데이터와 본문을 삭제합니다.
+func (s *Service) DeleteAttachment(ctx context.Context, taskID domain.TaskID, id domain.AttachmentID) error {
+	attachment, err := s.getTaskAttachment(ctx, taskID, id)
+	if err != nil {
+		return err
+	}
+
+	return s.deleteAttachment(ctx, attachment)
+}
+
+// getTaskAttachment taskID인 Task의 첨부 파일을 읽습니다. Task가 휴지통에 있으면 core.ErrTaskNotFound를,
+// 첨부 파일이 없거나 다른 Task의 것이면 core.ErrAttachmentNotFound를 반환합니다.
+func (s *Service) getTaskAttachment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	id domain.AttachmentID,
+) (*domain.Attachment, error) {
+	_, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.repo.GetAttachment(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	if attachment.TaskID() != taskID {
+		return nil, fmt.Errorf("failed to get attachment: %w", core.ErrAttachmentNotFound)
+	}
+
+	return attachment, nil
+}
+
+// deleteAttachment 본문을 먼저 지운 뒤 메타데이터를 지웁니다. 본문을 지우지 못하면 메타데이터가 남아 있으므로
+// 다시 시도할 수 있고, 본문이 blob 저장소에 버려진 채로 남지 않습니다.
+func (s *Service) deleteAttachment(ctx context.Context, attachment *domain.Attachment) error {
+	err := s.blobs.Delete(ctx, attachment.Blob().Key)
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	err = s.repo.DeleteAttachment(ctx, attachment.ID())
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	return nil
+}
+
+// sweepPurgedAttachments 영구 삭제된 Task에 남아 있는 첨부 파일을 모두 삭제합니다.
+func (s *Service) sweepPurgedAttachments(ctx context.Context) error {
+	for {
+		attachments, err := s.repo.ListPurgedAttachments(ctx, purgeAttachmentBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list purged attachments: %w", err)
+		}
+
+		if len(attachments) == 0 {
+			return nil
+		}
+
+		err = s.purgeAttachments(ctx, attachments)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// purgeAttachments 첨부 파일을 삭제합니다. 다른 요청이나 복제본이 먼저 삭제한 첨부 파일은 건너뜁니다.
+func (s *Service) purgeAttachments(ctx context.Context, attachments []*domain.Attachment) error {
+	for _, attachment := range attachments {
+		err := s.deleteAttachment(ctx, attachment)
+		if err != nil && !errors.Is(err, core.ErrAttachmentNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
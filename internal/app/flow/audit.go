@@ -0,0 +1,31 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+// ListAuditEntries 모든 Task의 감사 기록을 기록된 순서로 조회합니다.
+func (s *Service) ListAuditEntries(ctx context.Context, query *core.AuditQuery) (*core.AuditPage, error) {
+	err := query.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit entries: %w", err)
+	}
+
+	page, err := s.repo.ListAuditEntries(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit entries: %w", err)
+	}
+
+	return page, nil
+}
+
+// TaskHistory Task 하나의 감사 기록을 조회합니다. 휴지통에 있거나 영구 삭제된 Task의 기록도 조회됩니다.
+func (s *Service) TaskHistory(ctx context.Context, id domain.TaskID, query *core.AuditQuery) (*core.AuditPage, error) {
+	query.TaskID = id
+
+	return s.ListAuditEntries(ctx, query)
+}
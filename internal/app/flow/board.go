@@ -0,0 +1,184 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+// BoardLane 보드의 열 하나와 그 열에 순서대로 놓인 Task입니다.
+type BoardLane struct {
+	Column domain.BoardColumn
+	Tasks  []*domain.Task
+}
+
+// SetProjectColumns 프로젝트 보드의 열을 바꿉니다. columns는 domain.NewBoardColumns로 검증한 값이어야 하며,
+// 어느 열에도 속하지 않는 상태의 Task는 보드에서 보이지 않게 됩니다. expectedVersion은 UpdateProject와 같습니다.
+func (s *Service) SetProjectColumns(
+	ctx context.Context,
+	id domain.ProjectID,
+	columns []domain.BoardColumn,
+	expectedVersion int,
+) (*domain.Project, error) {
+	project, err := s.getProjectWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err = s.repo.UpdateProject(ctx, project.SetColumns(columns, s.stamp(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project: %w", err)
+	}
+
+	return project, nil
+}
+
+// GetBoard 프로젝트 보드의 열마다 놓인 Task를 순서대로 조회합니다.
+func (s *Service) GetBoard(ctx context.Context, id domain.ProjectID) ([]BoardLane, error) {
+	project, err := s.GetProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter core.TaskFilter
+
+	filter.ProjectID = id
+
+	tasks, err := s.collectTasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(tasks, domain.CompareRank)
+
+	columns := project.Columns()
+
+	lanes := make([]BoardLane, len(columns))
+	for i, column := range columns {
+		lanes[i] = BoardLane{Column: column, Tasks: columnTasks(tasks, column)}
+	}
+
+	return lanes, nil
+}
+
+// placeOnBoard Task를 프로젝트 보드의 columnKey 열에서 afterID 바로 뒤로 옮긴 Task를 반환합니다.
+// 이미 그 위치에 있으면 task를 그대로 반환합니다.
+func (s *Service) placeOnBoard(
+	ctx context.Context,
+	task *domain.Task,
+	columnKey string,
+	afterID domain.TaskID,
+) (*domain.Task, error) {
+	if task.ProjectID() == "" {
+		return nil, fmt.Errorf("%w: %s", ErrTaskNotOnBoard, task.ID())
+	}
+
+	project, err := s.GetProject(ctx, task.ProjectID())
+	if err != nil {
+		return nil, err
+	}
+
+	column, err := boardColumn(project, task, columnKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, err := s.columnCards(ctx, project.ID(), column, task.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	index := 0
+	if afterID != "" {
+		index = slices.IndexFunc(cards, func(card *domain.Task) bool { return card.ID() == afterID }) + 1
+		if index == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrPositionTaskNotFound, afterID)
+		}
+	}
+
+	lower, upper := neighbourRanks(cards, index)
+	if slices.Contains(column.Statuses(), task.Status()) && rankWithin(task.Rank(), lower, upper) {
+		return task, nil
+	}
+
+	return task.MoveOnBoard(column, domain.RankBetween(lower, upper)) //nolint:wrapcheck
+}
+
+// columnCards column 열에 놓인 Task를 exclude를 빼고 보드 순서대로 읽습니다.
+func (s *Service) columnCards(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	column domain.BoardColumn,
+	exclude domain.TaskID,
+) ([]*domain.Task, error) {
+	var filter core.TaskFilter
+
+	filter.ProjectID = projectID
+	filter.Statuses = column.Statuses()
+
+	cards, err := s.collectTasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	cards = slices.DeleteFunc(cards, func(card *domain.Task) bool { return card.ID() == exclude })
+	slices.SortFunc(cards, domain.CompareRank)
+
+	return cards, nil
+}
+
+// boardColumn 키가 columnKey인 열을 찾습니다. columnKey가 비어 있으면 Task가 지금 놓인 열입니다.
+func boardColumn(project *domain.Project, task *domain.Task, columnKey string) (domain.BoardColumn, error) {
+	if columnKey == "" {
+		column, ok := project.ColumnOf(task.Status())
+		if !ok {
+			return domain.BoardColumn{}, fmt.Errorf("%w: no column for status %s", ErrColumnNotFound, task.Status())
+		}
+
+		return column, nil
+	}
+
+	column, ok := project.Column(columnKey)
+	if !ok {
+		return domain.BoardColumn{}, fmt.Errorf("%w: %s", ErrColumnNotFound, columnKey)
+	}
+
+	return column, nil
+}
+
+// neighbourRanks 순서대로 정렬된 cards의 index 위치에 끼워 넣을 때 앞뒤 Task의 순서를 반환합니다.
+// 앞이나 뒤에 Task가 없으면 빈 문자열입니다.
+func neighbourRanks(cards []*domain.Task, index int) (string, string) {
+	var lower, upper string
+
+	if index > 0 {
+		lower = cards[index-1].Rank()
+	}
+
+	if index < len(cards) {
+		upper = cards[index].Rank()
+	}
+
+	return lower, upper
+}
+
+// rankWithin rank가 lower와 upper 사이에 있는지 확인합니다. 빈 lower와 upper는 각각 맨 앞과 맨 뒤입니다.
+func rankWithin(rank, lower, upper string) bool {
+	return (lower == "" || lower < rank) && (upper == "" || rank < upper)
+}
+
+// columnTasks 순서대로 정렬된 tasks 중 column 열에 놓이는 Task를 고릅니다.
+func columnTasks(tasks []*domain.Task, column domain.BoardColumn) []*domain.Task {
+	ret := make([]*domain.Task, 0)
+
+	for _, task := range tasks {
+		if slices.Contains(column.Statuses(), task.Status()) {
+			ret = append(ret, task)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,121 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/oklog/ulid/v2"
+)
+
+// AddChecklistItem Task의 체크리스트 끝에 항목을 추가합니다. expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) AddChecklistItem(
+	ctx context.Context,
+	id domain.TaskID,
+	text string,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := task.AddChecklistItem(domain.ChecklistItemID(ulid.Make().String()), text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add checklist item: %w", err)
+	}
+
+	return s.saveTask(ctx, added.Touch(s.stamp(ctx)))
+}
+
+// ReorderChecklist Task의 체크리스트를 itemIDs 순서로 재배열합니다. itemIDs는 모든 항목을 한 번씩 담아야 하며,
+// 순서가 바뀌지 않으면 Task를 그대로 반환합니다. expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) ReorderChecklist(
+	ctx context.Context,
+	id domain.TaskID,
+	itemIDs []domain.ChecklistItemID,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	reordered, err := task.ReorderChecklist(itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reorder checklist: %w", err)
+	}
+
+	if slices.Equal(reordered.Checklist(), task.Checklist()) {
+		return task, nil
+	}
+
+	return s.saveTask(ctx, reordered.Touch(s.stamp(ctx)))
+}
+
+// CheckChecklistItem 체크리스트 항목을 완료로 표시합니다. 이미 완료되었으면 Task를 그대로 반환합니다.
+// expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) CheckChecklistItem(
+	ctx context.Context,
+	id domain.TaskID,
+	itemID domain.ChecklistItemID,
+	expectedVersion int,
+) (*domain.Task, error) {
+	return s.setChecklistItemDone(ctx, id, itemID, true, expectedVersion)
+}
+
+// UncheckChecklistItem 체크리스트 항목의 완료 표시를 해제합니다. 완료되지 않았으면 Task를 그대로 반환합니다.
+// expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) UncheckChecklistItem(
+	ctx context.Context,
+	id domain.TaskID,
+	itemID domain.ChecklistItemID,
+	expectedVersion int,
+) (*domain.Task, error) {
+	return s.setChecklistItemDone(ctx, id, itemID, false, expectedVersion)
+}
+
+// RemoveChecklistItem 체크리스트에서 항목을 삭제합니다. expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) RemoveChecklistItem(
+	ctx context.Context,
+	id domain.TaskID,
+	itemID domain.ChecklistItemID,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := task.RemoveChecklistItem(itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove checklist item: %w", err)
+	}
+
+	return s.saveTask(ctx, removed.Touch(s.stamp(ctx)))
+}
+
+func (s *Service) setChecklistItemDone(
+	ctx context.Context,
+	id domain.TaskID,
+	itemID domain.ChecklistItemID,
+	done bool,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := task.SetChecklistItemDone(itemID, done)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update checklist item: %w", err)
+	}
+
+	if slices.Equal(updated.Checklist(), task.Checklist()) {
+		return task, nil
+	}
+
+	return s.saveTask(ctx, updated.Touch(s.stamp(ctx)))
+}
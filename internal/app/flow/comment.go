@@ -0,0 +1,141 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+// ListComments Task의 댓글을 답글 관계에 따라 묶어 작성 순서로 조회합니다.
+func (s *Service) ListComments(ctx context.Context, taskID domain.TaskID) ([]*domain.CommentThread, error) {
+	_, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.repo.ListComments(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	return domain.BuildCommentThreads(comments), nil
+}
+
+// CreateComment Task에 댓글을 작성합니다. parentID가 비어 있지 않으면 같은 Task의 댓글에 답글을 답니다.
+// 답글을 달 댓글이 없으면 ErrParentCommentNotFound를 반환합니다. 작성자는 ctx의 actor입니다.
+func (s *Service) CreateComment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	parentID domain.CommentID,
+	spec *domain.CommentSpec,
+) (*domain.Comment, error) {
+	_, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID != "" {
+		parent, err := s.repo.GetComment(ctx, parentID)
+		if err == nil && parent.TaskID() != taskID {
+			err = core.ErrCommentNotFound
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrParentCommentNotFound, parentID, err)
+		}
+	}
+
+	comment, err := s.repo.CreateComment(ctx, taskID, parentID, spec, s.stamp(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+// UpdateComment 댓글의 본문을 수정합니다. 작성자가 아니거나 actor가 AnonymousActor이면 ErrCommentForbidden을
+// 반환합니다.
+func (s *Service) UpdateComment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	id domain.CommentID,
+	spec *domain.CommentSpec,
+) (*domain.Comment, error) {
+	comment, err := s.getOwnComment(ctx, taskID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err = s.repo.UpdateComment(ctx, comment.SetSpec(spec).Touch(s.stamp(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+// DeleteComment 댓글과 그 아래의 모든 답글을 삭제합니다. 답글은 작성자와 관계없이 함께 삭제됩니다.
+// 작성자가 아니거나 actor가 AnonymousActor이면 ErrCommentForbidden을 반환합니다.
+func (s *Service) DeleteComment(ctx context.Context, taskID domain.TaskID, id domain.CommentID) error {
+	_, err := s.getOwnComment(ctx, taskID, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteComment(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	return nil
+}
+
+// getOwnComment Task의 댓글을 읽고 ctx의 actor가 작성자인지 확인합니다. 익명 actor는 서로 구별할 수 없으므로
+// 익명으로 작성한 댓글이라도 수정하거나 삭제할 수 없습니다.
+func (s *Service) getOwnComment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	id domain.CommentID,
+) (*domain.Comment, error) {
+	comment, err := s.getTaskComment(ctx, taskID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := ActorFrom(ctx)
+	if actor == AnonymousActor {
+		return nil, fmt.Errorf("%w: anonymous actors cannot modify %s", ErrCommentForbidden, id)
+	}
+
+	if comment.Author() != actor {
+		return nil, fmt.Errorf("%w: %s is not the author of %s", ErrCommentForbidden, actor, id)
+	}
+
+	return comment, nil
+}
+
+// getTaskComment taskID인 Task에 달린 댓글을 읽습니다. Task가 휴지통에 있으면 core.ErrTaskNotFound를,
+// 댓글이 없거나 다른 Task에 달려 있으면 core.ErrCommentNotFound를 반환합니다.
+func (s *Service) getTaskComment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	id domain.CommentID,
+) (*domain.Comment, error) {
+	_, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.repo.GetComment(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	if comment.TaskID() != taskID {
+		return nil, fmt.Errorf("failed to get comment: %w", core.ErrCommentNotFound)
+	}
+
+	return comment, nil
+}
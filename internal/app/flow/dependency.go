@@ -0,0 +1,177 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+// AddDependency id인 Task가 dependsOn인 Task에 의해 막히도록 의존성을 추가합니다.
+// 의존성은 Task의 내용이 아니므로 Task의 버전은 바뀌지 않습니다.
+// 의존성을 따라가서 자기 자신으로 돌아오게 되면 domain.ErrDependencyCycle을 반환합니다.
+func (s *Service) AddDependency(ctx context.Context, id, dependsOn domain.TaskID) error {
+	_, err := s.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetTask(ctx, dependsOn)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrDependencyTaskNotFound, dependsOn, err)
+	}
+
+	err = s.repo.AddDependency(ctx, domain.NewDependency(id, dependsOn))
+	if errors.Is(err, domain.ErrDependencyCycle) {
+		return fmt.Errorf("%w: %s already depends on %s", domain.ErrDependencyCycle, dependsOn, id)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to add dependency: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) RemoveDependency(ctx context.Context, id, dependsOn domain.TaskID) error {
+	err := s.repo.RemoveDependency(ctx, domain.NewDependency(id, dependsOn))
+	if err != nil {
+		return fmt.Errorf("failed to remove dependency: %w", err)
+	}
+
+	return nil
+}
+
+// ListDependencies id인 Task를 막고 있는 선행 Task를 ID 순서로 조회합니다.
+func (s *Service) ListDependencies(ctx context.Context, id domain.TaskID) ([]*domain.Task, error) {
+	_, err := s.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	deps, err := s.repo.ListDependencies(ctx, []domain.TaskID{id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dependencies: %w", err)
+	}
+
+	ids := make([]domain.TaskID, len(deps))
+	for i, dep := range deps {
+		ids[i] = dep.DependsOn()
+	}
+
+	return s.tasksByID(ctx, ids)
+}
+
+// ListDependents id인 Task에 의해 막혀 있는 후행 Task를 ID 순서로 조회합니다.
+func (s *Service) ListDependents(ctx context.Context, id domain.TaskID) ([]*domain.Task, error) {
+	_, err := s.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	deps, err := s.repo.ListDependents(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dependents: %w", err)
+	}
+
+	ids := make([]domain.TaskID, len(deps))
+	for i, dep := range deps {
+		ids[i] = dep.Task()
+	}
+
+	return s.tasksByID(ctx, ids)
+}
+
+// BlockedTaskIDs tasks 중 끝나지 않은(완료나 취소가 아닌) 선행 Task가 하나라도 있는 Task의 ID를 반환합니다.
+func (s *Service) BlockedTaskIDs(ctx context.Context, tasks []*domain.Task) (map[domain.TaskID]bool, error) {
+	ids := make([]domain.TaskID, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID()
+	}
+
+	deps, err := s.repo.ListDependencies(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dependencies: %w", err)
+	}
+
+	blockerIDs := make([]domain.TaskID, len(deps))
+	for i, dep := range deps {
+		blockerIDs[i] = dep.DependsOn()
+	}
+
+	blockers, err := s.tasksByID(ctx, blockerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	open := make(map[domain.TaskID]bool, len(blockers))
+	for _, blocker := range blockers {
+		open[blocker.ID()] = !blocker.Status().IsClosed()
+	}
+
+	blocked := make(map[domain.TaskID]bool)
+
+	for _, dep := range deps {
+		if open[dep.DependsOn()] {
+			blocked[dep.Task()] = true
+		}
+	}
+
+	return blocked, nil
+}
+
+// ExecutionOrder ids의 Task를 선행 Task가 항상 먼저 오도록 정렬하여 반환합니다.
+// ids 밖의 Task와의 의존성은 고려하지 않으며, 없는 Task가 있으면 core.ErrTaskNotFound를 반환합니다.
+func (s *Service) ExecutionOrder(ctx context.Context, ids []domain.TaskID) ([]*domain.Task, error) {
+	ids = slices.Compact(slices.Sorted(slices.Values(ids)))
+	if len(ids) == 0 || len(ids) > core.MaxListLimit {
+		return nil, fmt.Errorf("%w: between 1 and %d task IDs are required", core.ErrInvalidQuery, core.MaxListLimit)
+	}
+
+	tasks, err := s.tasksByID(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tasks) != len(ids) {
+		return nil, fmt.Errorf("failed to order tasks: %w", core.ErrTaskNotFound)
+	}
+
+	deps, err := s.repo.ListDependencies(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dependencies: %w", err)
+	}
+
+	ordered, err := domain.OrderByDependencies(ids, deps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to order tasks: %w", err)
+	}
+
+	byID := make(map[domain.TaskID]*domain.Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID()] = task
+	}
+
+	ret := make([]*domain.Task, len(ordered))
+	for i, id := range ordered {
+		ret[i] = byID[id]
+	}
+
+	return ret, nil
+}
+
+// tasksByID ids의 Task를 ID 순서로 읽습니다. 없는 Task는 건너뜁니다.
+func (s *Service) tasksByID(ctx context.Context, ids []domain.TaskID) ([]*domain.Task, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var filter core.TaskFilter
+
+	filter.IDs = ids
+
+	return s.collectTasks(ctx, filter)
+}
@@ -0,0 +1,23 @@
+package flow
+
+import "errors"
+
+var (
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrReservedActor      = errors.New("actor name is reserved")
+	ErrParentNotFound     = errors.New("parent task not found")
+	ErrParentCycle        = errors.New("task cannot be moved under itself or its subtasks")
+
+	ErrTaskProjectNotFound = errors.New("task project not found")
+
+	ErrTaskNotOnBoard       = errors.New("task does not belong to a project board")
+	ErrColumnNotFound       = errors.New("board column not found")
+	ErrPositionTaskNotFound = errors.New("position task not found in column")
+
+	ErrDependencyTaskNotFound = errors.New("dependency task not found")
+
+	ErrParentCommentNotFound = errors.New("parent comment not found")
+	ErrCommentForbidden      = errors.New("only the author can modify the comment")
+
+	ErrAttachmentTooLarge = errors.New("attachment is too large")
+)
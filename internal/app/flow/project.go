@@ -0,0 +1,150 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+func (s *Service) CreateProject(
+	ctx context.Context,
+	key domain.ProjectKey,
+	spec *domain.ProjectSpec,
+) (*domain.Project, error) {
+	project, err := s.repo.CreateProject(ctx, key, spec, s.stamp(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	return project, nil
+}
+
+func (s *Service) ListProjects(ctx context.Context) ([]*domain.Project, error) {
+	projects, err := s.repo.ListProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	return projects, nil
+}
+
+func (s *Service) GetProject(ctx context.Context, id domain.ProjectID) (*domain.Project, error) {
+	project, err := s.repo.GetProject(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	return project, nil
+}
+
+// UpdateProject 프로젝트의 이름과 설명, 기본값을 수정합니다. 키는 바꿀 수 없으며,
+// 바뀐 기본값은 이후에 만드는 Task에만 적용됩니다. expectedVersion이 0이 아니고 현재 버전과 다르면
+// ErrPreconditionFailed를, 읽은 뒤 다른 요청이 먼저 수정했으면 core.ErrProjectConflict를 반환합니다.
+func (s *Service) UpdateProject(
+	ctx context.Context,
+	id domain.ProjectID,
+	spec *domain.ProjectSpec,
+	expectedVersion int,
+) (*domain.Project, error) {
+	project, err := s.getProjectWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err = s.repo.UpdateProject(ctx, project.SetSpec(spec, s.stamp(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project: %w", err)
+	}
+
+	return project, nil
+}
+
+// DeleteProject 프로젝트를 삭제합니다. 휴지통에 있는 것을 포함하여 속한 Task가 있으면 삭제할 수 없습니다.
+func (s *Service) DeleteProject(ctx context.Context, id domain.ProjectID) error {
+	err := s.repo.DeleteProject(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	return nil
+}
+
+// ListProjectTasks 프로젝트에 속한 Task를 조회합니다.
+func (s *Service) ListProjectTasks(
+	ctx context.Context,
+	id domain.ProjectID,
+	query *core.ListTasksQuery,
+) (*core.TaskPage, error) {
+	_, err := s.GetProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Filter.ProjectID = id
+
+	return s.ListTasks(ctx, query)
+}
+
+// GetTaskByKey 프로젝트 키가 붙은 Task 키(예: OPS-42)로 Task를 조회합니다. 대소문자를 구분하지 않습니다.
+func (s *Service) GetTaskByKey(ctx context.Context, key string) (*domain.Task, error) {
+	var filter core.TaskFilter
+
+	filter.Key = strings.ToUpper(key)
+
+	page, err := s.ListTasks(ctx, &core.ListTasksQuery{
+		Filter: filter,
+		Sort:   core.TaskSort{Field: core.TaskSortID, Descending: false},
+		Limit:  1,
+		Cursor: nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(page.Tasks) == 0 {
+		return nil, fmt.Errorf("failed to get task: %w", core.ErrTaskNotFound)
+	}
+
+	return page.Tasks[0], nil
+}
+
+// getProjectWithVersion 프로젝트를 읽고 expectedVersion이 0이 아니면 현재 버전과 일치하는지 확인합니다.
+func (s *Service) getProjectWithVersion(
+	ctx context.Context,
+	id domain.ProjectID,
+	expectedVersion int,
+) (*domain.Project, error) {
+	project, err := s.GetProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedVersion != 0 && project.Version() != expectedVersion {
+		return nil, fmt.Errorf("%w: expected version %d, current version %d",
+			ErrPreconditionFailed, expectedVersion, project.Version())
+	}
+
+	return project, nil
+}
+
+// applyProjectDefaults spec에 프로젝트가 지정되면 프로젝트의 기본값을 채웁니다.
+func (s *Service) applyProjectDefaults(ctx context.Context, spec *domain.TaskSpec) (*domain.TaskSpec, error) {
+	if spec.ProjectID() == "" {
+		return spec, nil
+	}
+
+	project, err := s.repo.GetProject(ctx, spec.ProjectID())
+	if err != nil {
+		if errors.Is(err, core.ErrProjectNotFound) {
+			return nil, fmt.Errorf("%w: %s: %w", ErrTaskProjectNotFound, spec.ProjectID(), err)
+		}
+
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	return spec.WithProjectDefaults(project), nil
+}
@@ -0,0 +1,92 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+// ListTaskRevisions Task의 리비전을 버전 순서로 조회합니다.
+func (s *Service) ListTaskRevisions(ctx context.Context, id domain.TaskID) ([]*domain.Task, error) {
+	_, err := s.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.repo.ListRevisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// GetTaskRevision Task의 version 리비전을 조회합니다.
+func (s *Service) GetTaskRevision(ctx context.Context, id domain.TaskID, version int) (*domain.Task, error) {
+	_, err := s.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getRevision(ctx, id, version)
+}
+
+// DiffTaskRevisions from 리비전에서 to 리비전으로 바뀐 필드를 조회합니다. to가 0이면 현재 버전과 비교합니다.
+func (s *Service) DiffTaskRevisions(ctx context.Context, id domain.TaskID, from, to int) (domain.RevisionDiff, error) {
+	task, err := s.GetTask(ctx, id)
+	if err != nil {
+		return domain.RevisionDiff{}, err
+	}
+
+	if to == 0 {
+		to = task.Version()
+	}
+
+	fromRevision, err := s.getRevision(ctx, id, from)
+	if err != nil {
+		return domain.RevisionDiff{}, err
+	}
+
+	toRevision, err := s.getRevision(ctx, id, to)
+	if err != nil {
+		return domain.RevisionDiff{}, err
+	}
+
+	return domain.NewRevisionDiff(fromRevision, toRevision), nil
+}
+
+// RevertTask Task의 제목, 설명, 우선순위, 마감일을 version 리비전의 값으로 되돌립니다.
+// 되돌린 내용은 새 버전으로 저장되며, 바뀌는 것이 없으면 Task를 그대로 반환합니다. expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) RevertTask(
+	ctx context.Context,
+	id domain.TaskID,
+	version int,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := s.getRevision(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	reverted := task.Revert(revision)
+	if len(domain.DiffTasks(task, reverted)) == 0 {
+		return task, nil
+	}
+
+	return s.saveTask(ctx, reverted.Touch(s.stamp(ctx)))
+}
+
+func (s *Service) getRevision(ctx context.Context, id domain.TaskID, version int) (*domain.Task, error) {
+	revision, err := s.repo.GetRevision(ctx, id, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	return revision, nil
+}
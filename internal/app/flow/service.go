@@ -3,21 +3,78 @@ package flow
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/neatflowcv/tasker/internal/pkg/blob"
 	"github.com/neatflowcv/tasker/internal/pkg/domain"
 	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
 )
 
+// Clock 현재 시각을 반환합니다. 테스트에서 시각을 고정할 수 있도록 주입합니다.
+type Clock func() time.Time
+
+// DefaultMaxAttachmentSize WithMaxAttachmentSize를 지정하지 않았을 때의 첨부 파일 최대 크기(10 MiB)입니다.
+const DefaultMaxAttachmentSize int64 = 10 << 20
+
 type Service struct {
-	repo core.Repository
+	repo  core.Repository
+	clock Clock
+	// blobs 첨부 파일 본문을 저장합니다.
+	blobs             blob.Store
+	maxAttachmentSize int64
+}
+
+// Option Service의 선택 설정입니다.
+type Option func(*Service)
+
+// WithBlobStore 첨부 파일 본문을 store에 저장합니다. 지정하지 않으면 메모리에 저장합니다.
+func WithBlobStore(store blob.Store) Option {
+	return func(s *Service) {
+		s.blobs = store
+	}
+}
+
+// WithMaxAttachmentSize 첨부 파일의 최대 크기를 바이트 단위로 지정합니다.
+func WithMaxAttachmentSize(size int64) Option {
+	return func(s *Service) {
+		s.maxAttachmentSize = size
+	}
 }
 
-func NewService(repo core.Repository) *Service {
-	return &Service{repo: repo}
+func NewService(repo core.Repository, opts ...Option) *Service {
+	return NewServiceWithClock(repo, time.Now, opts...)
 }
 
+// NewServiceWithClock 생성·수정 시각을 clock으로 기록하는 Service를 생성합니다.
+func NewServiceWithClock(repo core.Repository, clock Clock, opts ...Option) *Service {
+	service := &Service{
+		repo:              repo,
+		clock:             clock,
+		blobs:             blob.NewMemoryStore(),
+		maxAttachmentSize: DefaultMaxAttachmentSize,
+	}
+
+	for _, opt := range opts {
+		opt(service)
+	}
+
+	return service
+}
+
+// CreateTask Task를 생성합니다. 상위 Task가 지정되면 존재해야 합니다.
+// 프로젝트가 지정되면 존재해야 하며, 지정하지 않은 값은 프로젝트의 기본값으로 채웁니다.
 func (s *Service) CreateTask(ctx context.Context, spec *domain.TaskSpec) (*domain.Task, error) {
-	task, err := s.repo.CreateTask(spec)
+	err := s.checkParent(ctx, "", spec.ParentID())
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err = s.applyProjectDefaults(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.repo.CreateTask(ctx, spec, s.stamp(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
@@ -25,17 +82,30 @@ func (s *Service) CreateTask(ctx context.Context, spec *domain.TaskSpec) (*domai
 	return task, nil
 }
 
-func (s *Service) ListTasks(ctx context.Context) ([]*domain.Task, error) {
-	tasks, err := s.repo.ListTasks()
+func (s *Service) ListTasks(ctx context.Context, query *core.ListTasksQuery) (*core.TaskPage, error) {
+	err := query.Validate()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list tasks: %w", err)
 	}
 
-	return tasks, nil
+	page, err := s.repo.ListTasks(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	return page, nil
+}
+
+// ListOverdueTasks clock 기준으로 마감일이 지났지만 끝나지 않은 Task를 조회합니다.
+func (s *Service) ListOverdueTasks(ctx context.Context, query *core.ListTasksQuery) (*core.TaskPage, error) {
+	now := s.clock()
+	query.Filter.OverdueAt = &now
+
+	return s.ListTasks(ctx, query)
 }
 
 func (s *Service) GetTask(ctx context.Context, id domain.TaskID) (*domain.Task, error) {
-	task, err := s.repo.GetTask(id)
+	task, err := s.repo.GetTask(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task: %w", err)
 	}
@@ -43,27 +113,113 @@ func (s *Service) GetTask(ctx context.Context, id domain.TaskID) (*domain.Task,
 	return task, nil
 }
 
-func (s *Service) UpdateTask(ctx context.Context, id domain.TaskID, spec *domain.TaskSpec) (*domain.Task, error) {
-	task, err := s.repo.GetTask(id)
+// UpdateTask Task를 수정합니다. expectedVersion이 0이 아니면 현재 버전과 일치할 때만 수정합니다.
+func (s *Service) UpdateTask(
+	ctx context.Context,
+	id domain.TaskID,
+	spec *domain.TaskSpec,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get task: %w", err)
+		return nil, err
 	}
 
-	updatedTask := task.SetSpec(spec)
+	return s.saveTask(ctx, task.SetSpec(spec).Touch(s.stamp(ctx)))
+}
 
-	updatedTask, err = s.repo.UpdateTask(updatedTask)
+// PatchTask 패치에 지정된 필드만 수정합니다. expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) PatchTask(
+	ctx context.Context,
+	id domain.TaskID,
+	patch *domain.TaskPatch,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update task: %w", err)
+		return nil, err
+	}
+
+	if patch.IsEmpty() {
+		return task, nil
 	}
 
-	return updatedTask, nil
+	next, err := task.ApplyPatch(patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch task: %w", err)
+	}
+
+	return s.saveTask(ctx, next.Touch(s.stamp(ctx)))
 }
 
-func (s *Service) DeleteTask(ctx context.Context, id domain.TaskID) error {
-	err := s.repo.DeleteTask(id)
+// DeleteTask Task를 휴지통으로 옮깁니다. 하위 Task는 policy에 따라 함께 휴지통으로 옮겨지거나 최상위 Task가 됩니다.
+// 하위 Task를 먼저 처리하므로 중간에 실패해도 상위 Task가 없는 하위 Task는 남지 않습니다.
+func (s *Service) DeleteTask(ctx context.Context, id domain.TaskID, policy SubtaskPolicy) error {
+	_, err := s.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.deleteSubtasks(ctx, id, policy)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteTask(ctx, id, s.stamp(ctx))
 	if err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
 	}
 
 	return nil
 }
+
+// TransitionTask Task의 상태를 전이합니다. expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) TransitionTask(
+	ctx context.Context,
+	id domain.TaskID,
+	action domain.TaskAction,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	transitioned, err := task.Transition(action)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transition task: %w", err)
+	}
+
+	return s.saveTask(ctx, transitioned.Touch(s.stamp(ctx)))
+}
+
+func (s *Service) getTaskWithVersion(ctx context.Context, id domain.TaskID, expectedVersion int) (*domain.Task, error) {
+	task, err := s.repo.GetTask(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	if expectedVersion != 0 && task.Version() != expectedVersion {
+		return nil, fmt.Errorf("%w: expected version %d, current version %d",
+			ErrPreconditionFailed, expectedVersion, task.Version())
+	}
+
+	return task, nil
+}
+
+// saveTask after를 저장합니다. 저장소가 같은 트랜잭션에서 감사 기록을 남깁니다.
+// after는 Touch로 수정 정보가 기록되어 있어야 합니다.
+func (s *Service) saveTask(ctx context.Context, after *domain.Task) (*domain.Task, error) {
+	saved, err := s.repo.UpdateTask(ctx, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+
+	return saved, nil
+}
+
+// stamp 현재 시각과 ctx의 actor로 Stamp를 만듭니다.
+// 저장소마다 시각 정밀도가 다르므로 PostgreSQL이 보존하는 마이크로초 단위로 자릅니다.
+func (s *Service) stamp(ctx context.Context) domain.Stamp {
+	return domain.NewStamp(s.clock().UTC().Truncate(time.Microsecond), ActorFrom(ctx))
+}
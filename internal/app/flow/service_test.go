@@ -0,0 +1,260 @@
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/fake"
+)
+
+// TestServiceCancelledContext 취소된 컨텍스트가 저장소까지 전달되어 모든 메서드가 context.Canceled로 실패하는지 확인합니다.
+func TestServiceCancelledContext(t *testing.T) {
+	t.Parallel()
+
+	service := flow.NewService(fake.NewRepository())
+	task := mustCreateTask(t, service, "report")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	calls := map[string]func() error{
+		"CreateTask": func() error {
+			_, err := service.CreateTask(ctx, newSpec(t, "other"))
+
+			return err
+		},
+		"ListTasks": func() error {
+			_, err := service.ListTasks(ctx, &core.ListTasksQuery{}) //nolint:exhaustruct
+
+			return err
+		},
+		"GetTask": func() error {
+			_, err := service.GetTask(ctx, task.ID())
+
+			return err
+		},
+		"UpdateTask": func() error {
+			_, err := service.UpdateTask(ctx, task.ID(), newSpec(t, "renamed"), 0)
+
+			return err
+		},
+		"TransitionTask": func() error {
+			_, err := service.TransitionTask(ctx, task.ID(), domain.TaskActionStart, 0)
+
+			return err
+		},
+		"DeleteTask": func() error {
+			return service.DeleteTask(ctx, task.ID(), flow.SubtaskPolicyOrphan)
+		},
+	}
+
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, context.Canceled) {
+			t.Errorf("%s error = %v, want %v", name, err, context.Canceled)
+		}
+	}
+
+	// 취소된 요청은 아무것도 바꾸지 않아야 합니다.
+	got, err := service.GetTask(t.Context(), task.ID())
+	if err != nil || got.Title() != "report" || got.Status() != domain.TaskStatusOpen {
+		t.Fatalf("GetTask = %v, %v, want the unchanged task", got, err)
+	}
+}
+
+// TestServiceListTasks 정렬 기준에 따라 커서로 페이지를 이어 읽을 수 있는지 확인합니다.
+func TestServiceListTasks(t *testing.T) {
+	t.Parallel()
+
+	service := flow.NewService(fake.NewRepository())
+	for _, title := range []string{"charlie", "alpha", "bravo"} {
+		mustCreateTask(t, service, title)
+	}
+
+	query := &core.ListTasksQuery{ //nolint:exhaustruct
+		Sort:  core.TaskSort{Field: core.TaskSortTitle, Descending: false},
+		Limit: 2,
+	}
+
+	first, err := service.ListTasks(t.Context(), query)
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+
+	if got := titles(first.Tasks); !slices.Equal(got, []string{"alpha", "bravo"}) || first.NextCursor == nil {
+		t.Fatalf("first page = %v, next %v, want [alpha bravo] with a cursor", got, first.NextCursor)
+	}
+
+	query.Cursor = first.NextCursor
+
+	second, err := service.ListTasks(t.Context(), query)
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+
+	if got := titles(second.Tasks); !slices.Equal(got, []string{"charlie"}) || second.NextCursor != nil {
+		t.Fatalf("second page = %v, next %v, want [charlie] without a cursor", got, second.NextCursor)
+	}
+
+	// 다른 정렬로 발급된 커서는 거부합니다.
+	query.Sort.Descending = true
+
+	_, err = service.ListTasks(t.Context(), query)
+	if !errors.Is(err, core.ErrInvalidCursor) {
+		t.Fatalf("ListTasks with a foreign cursor error = %v, want %v", err, core.ErrInvalidCursor)
+	}
+
+	_, err = service.ListTasks(t.Context(), &core.ListTasksQuery{Limit: core.MaxListLimit + 1}) //nolint:exhaustruct
+	if !errors.Is(err, core.ErrInvalidQuery) {
+		t.Fatalf("ListTasks over the limit error = %v, want %v", err, core.ErrInvalidQuery)
+	}
+}
+
+// TestServiceExpectedVersion 기대 버전이 현재 버전과 다르면 수정과 전이를 거부하고, 0이면 확인하지 않는지 확인합니다.
+func TestServiceExpectedVersion(t *testing.T) {
+	t.Parallel()
+
+	service := flow.NewService(fake.NewRepository())
+	task := mustCreateTask(t, service, "report")
+
+	updated, err := service.UpdateTask(t.Context(), task.ID(), newSpec(t, "renamed"), task.Version())
+	if err != nil || updated.Version() != task.Version()+1 {
+		t.Fatalf("UpdateTask = %v, %v, want version %d", updated, err, task.Version()+1)
+	}
+
+	_, err = service.UpdateTask(t.Context(), task.ID(), newSpec(t, "stale"), task.Version())
+	if !errors.Is(err, flow.ErrPreconditionFailed) {
+		t.Fatalf("UpdateTask with a stale version error = %v, want %v", err, flow.ErrPreconditionFailed)
+	}
+
+	_, err = service.TransitionTask(t.Context(), task.ID(), domain.TaskActionStart, task.Version())
+	if !errors.Is(err, flow.ErrPreconditionFailed) {
+		t.Fatalf("TransitionTask with a stale version error = %v, want %v", err, flow.ErrPreconditionFailed)
+	}
+
+	started, err := service.TransitionTask(t.Context(), task.ID(), domain.TaskActionStart, 0)
+	if err != nil || started.Version() != updated.Version()+1 || started.Title() != "renamed" {
+		t.Fatalf("TransitionTask = %v, %v, want version %d of the renamed task", started, err, updated.Version()+1)
+	}
+}
+
+// TestServiceConcurrentUpdates 동시에 들어온 수정이 서로를 덮어쓰지 않고, 성공한 수정마다 버전이 하나씩 오르는지 확인합니다.
+func TestServiceConcurrentUpdates(t *testing.T) {
+	t.Parallel()
+
+	const writers = 8
+
+	service := flow.NewService(fake.NewRepository())
+	task := mustCreateTask(t, service, "report")
+
+	var (
+		wg        sync.WaitGroup
+		succeeded atomic.Int64
+	)
+
+	for index := range writers {
+		spec, other := newSpec(t, fmt.Sprintf("writer %d", index)), newSpec(t, "other")
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := service.UpdateTask(t.Context(), task.ID(), spec, 0)
+			if err == nil {
+				succeeded.Add(1)
+			} else if !errors.Is(err, core.ErrConflict) {
+				t.Errorf("UpdateTask error = %v, want nil or %v", err, core.ErrConflict)
+			}
+
+			_, err = service.CreateTask(t.Context(), other)
+			if err != nil {
+				t.Errorf("CreateTask: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	got, err := service.GetTask(t.Context(), task.ID())
+	if err != nil || got.Version() != task.Version()+int(succeeded.Load()) {
+		t.Fatalf("GetTask = %v, %v, want version %d", got, err, task.Version()+int(succeeded.Load()))
+	}
+
+	page, err := service.ListTasks(t.Context(), &core.ListTasksQuery{Limit: core.MaxListLimit}) //nolint:exhaustruct
+	if err != nil || len(page.Tasks) != writers+1 {
+		t.Fatalf("ListTasks = %v, want %d tasks", err, writers+1)
+	}
+}
+
+// TestServicePersistentRepository 스냅샷 파일로 다시 연 저장소가 이전 상태를 그대로 이어받는지 확인합니다.
+func TestServicePersistentRepository(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "tasker.json")
+
+	repo, err := fake.NewPersistentRepository(path)
+	if err != nil {
+		t.Fatalf("NewPersistentRepository: %v", err)
+	}
+
+	service := flow.NewService(repo)
+	task := mustCreateTask(t, service, "report")
+
+	updated, err := service.UpdateTask(t.Context(), task.ID(), newSpec(t, "renamed"), 0)
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	reopened, err := fake.NewPersistentRepository(path)
+	if err != nil {
+		t.Fatalf("NewPersistentRepository: %v", err)
+	}
+
+	got, err := flow.NewService(reopened).GetTask(t.Context(), task.ID())
+	if err != nil || got.Title() != "renamed" || got.Version() != updated.Version() {
+		t.Fatalf("GetTask after reopening = %v, %v, want version %d of the renamed task", got, err, updated.Version())
+	}
+}
+
+func titles(tasks []*domain.Task) []string {
+	ret := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ret = append(ret, task.Title())
+	}
+
+	return ret
+}
+
+func newSpec(t *testing.T, title string) *domain.TaskSpec {
+	t.Helper()
+
+	spec, err := domain.NewTaskSpec(domain.TaskSpecParams{ //nolint:exhaustruct
+		Title:  title,
+		Status: domain.TaskStatusOpen,
+	})
+	if err != nil {
+		t.Fatalf("NewTaskSpec(%q): %v", title, err)
+	}
+
+	return spec
+}
+
+func mustCreateTask(t *testing.T, service *flow.Service, title string) *domain.Task {
+	t.Helper()
+
+	task, err := service.CreateTask(t.Context(), newSpec(t, title))
+	if err != nil {
+		t.Fatalf("CreateTask(%q): %v", title, err)
+	}
+
+	return task
+}
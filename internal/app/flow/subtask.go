@@ -0,0 +1,237 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+// SubtaskPolicy Task를 삭제할 때 하위 Task를 어떻게 처리할지 정합니다.
+type SubtaskPolicy string
+
+const (
+	// SubtaskPolicyOrphan 하위 Task를 최상위 Task로 만듭니다.
+	SubtaskPolicyOrphan SubtaskPolicy = "orphan"
+	// SubtaskPolicyCascade 모든 하위 Task를 함께 삭제합니다.
+	SubtaskPolicyCascade SubtaskPolicy = "cascade"
+)
+
+// ParseSubtaskPolicy 문자열을 SubtaskPolicy로 변환합니다. 빈 문자열은 SubtaskPolicyOrphan입니다.
+func ParseSubtaskPolicy(value string) (SubtaskPolicy, error) {
+	switch SubtaskPolicy(value) {
+	case "", SubtaskPolicyOrphan:
+		return SubtaskPolicyOrphan, nil
+	case SubtaskPolicyCascade:
+		return SubtaskPolicyCascade, nil
+	default:
+		return "", fmt.Errorf("%w: unknown subtask policy %q", core.ErrInvalidQuery, value)
+	}
+}
+
+// TaskMove MoveTask로 Task를 옮길 위치입니다. 상위 Task와 보드 위치를 함께 지정하면 한 번에 저장합니다.
+type TaskMove struct {
+	// ParentID nil이면 상위 Task를 바꾸지 않으며, 빈 ID를 가리키면 최상위 Task로 만듭니다.
+	ParentID *domain.TaskID
+	// Board true이면 Column과 AfterID에 따라 프로젝트 보드에서 옮깁니다.
+	Board bool
+	// Column 옮길 열의 키입니다. 비어 있으면 지금 놓인 열 안에서 순서만 바꿉니다.
+	Column string
+	// AfterID 열에서 바로 앞에 놓일 Task입니다. 비어 있으면 열의 맨 앞으로 옮깁니다.
+	AfterID domain.TaskID
+}
+
+// ListSubtasks Task의 바로 아래 하위 Task를 조회합니다.
+func (s *Service) ListSubtasks(
+	ctx context.Context,
+	id domain.TaskID,
+	query *core.ListTasksQuery,
+) (*core.TaskPage, error) {
+	_, err := s.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Filter.ParentID = id
+
+	return s.ListTasks(ctx, query)
+}
+
+// TaskProgress 모든 하위 Task의 완료 현황을 집계합니다.
+func (s *Service) TaskProgress(ctx context.Context, id domain.TaskID) (domain.Progress, error) {
+	descendants, err := s.descendants(ctx, id)
+	if err != nil {
+		return domain.Progress{}, err
+	}
+
+	return domain.NewProgress(descendants), nil
+}
+
+// MoveTask Task를 move가 가리키는 위치로 옮깁니다. 상위 Task와 보드 위치를 모두 바꾸어도 한 번만 저장되며,
+// 보드에서는 옮긴 Task의 순서만 바뀌고 같은 열의 다른 Task는 그대로입니다.
+// 상위 Task는 자기 자신이나 자신의 하위 Task일 수 없습니다. expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) MoveTask(
+	ctx context.Context,
+	id domain.TaskID,
+	move TaskMove,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	moved := task
+
+	if move.ParentID != nil && *move.ParentID != task.ParentID() {
+		err = s.checkParent(ctx, id, *move.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		moved = moved.WithParent(*move.ParentID)
+	}
+
+	if move.Board {
+		moved, err = s.placeOnBoard(ctx, moved, move.Column, move.AfterID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if moved == task {
+		return task, nil
+	}
+
+	return s.saveTask(ctx, moved.Touch(s.stamp(ctx)))
+}
+
+// checkParent parentID가 존재하고, id인 Task를 그 아래로 옮겨도 순환이 생기지 않는지 확인합니다.
+// 새 Task는 id가 비어 있으므로 존재 여부만 확인합니다. 동시에 옮겨져 이미 순환이 생긴 상위 Task를 만나도
+// 끝없이 따라가지 않고 ErrParentCycle을 반환합니다.
+func (s *Service) checkParent(ctx context.Context, id, parentID domain.TaskID) error {
+	visited := make(map[domain.TaskID]bool)
+
+	for ancestor := parentID; ancestor != ""; {
+		if ancestor == id {
+			return fmt.Errorf("%w: %s is a subtask of %s", ErrParentCycle, parentID, id)
+		}
+
+		if visited[ancestor] {
+			return fmt.Errorf("%w: parents of %s form a cycle", ErrParentCycle, parentID)
+		}
+
+		visited[ancestor] = true
+
+		task, err := s.repo.GetTask(ctx, ancestor)
+		if err != nil {
+			if ancestor == parentID {
+				return fmt.Errorf("%w: %s: %w", ErrParentNotFound, parentID, err)
+			}
+
+			return fmt.Errorf("failed to get task: %w", err)
+		}
+
+		ancestor = task.ParentID()
+	}
+
+	return nil
+}
+
+// deleteSubtasks 정책에 따라 Task의 하위 Task를 삭제하거나 최상위 Task로 만듭니다.
+// 함께 삭제할 때는 가장 아래의 Task부터 삭제합니다.
+func (s *Service) deleteSubtasks(ctx context.Context, id domain.TaskID, policy SubtaskPolicy) error {
+	if policy == SubtaskPolicyCascade {
+		descendants, err := s.descendants(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for index := len(descendants) - 1; index >= 0; index-- {
+			err = s.repo.DeleteTask(ctx, descendants[index].ID(), s.stamp(ctx))
+			if err != nil {
+				return fmt.Errorf("failed to delete subtasks: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	children, err := s.children(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		_, err = s.saveTask(ctx, child.WithParent("").Touch(s.stamp(ctx)))
+		if err != nil {
+			return fmt.Errorf("failed to delete subtasks: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// descendants 모든 하위 Task를 너비 우선으로 모으므로 상위 Task가 항상 그 하위 Task보다 앞에 옵니다.
+// 동시에 옮겨져 상위 관계에 순환이 생겼으면 ErrParentCycle을 반환합니다.
+func (s *Service) descendants(ctx context.Context, id domain.TaskID) ([]*domain.Task, error) {
+	var ret []*domain.Task
+
+	visited := map[domain.TaskID]bool{id: true}
+
+	for queue := []domain.TaskID{id}; len(queue) > 0; queue = queue[1:] {
+		children, err := s.children(ctx, queue[0])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, child := range children {
+			if visited[child.ID()] {
+				return nil, fmt.Errorf("%w: %s is a subtask of itself", ErrParentCycle, child.ID())
+			}
+
+			visited[child.ID()] = true
+
+			ret = append(ret, child)
+			queue = append(queue, child.ID())
+		}
+	}
+
+	return ret, nil
+}
+
+// children 바로 아래 하위 Task를 모든 페이지에 걸쳐 읽습니다.
+func (s *Service) children(ctx context.Context, id domain.TaskID) ([]*domain.Task, error) {
+	var filter core.TaskFilter
+
+	filter.ParentID = id
+
+	return s.collectTasks(ctx, filter)
+}
+
+// collectTasks filter에 맞는 Task를 모든 페이지에 걸쳐 ID 순서로 읽습니다.
+func (s *Service) collectTasks(ctx context.Context, filter core.TaskFilter) ([]*domain.Task, error) {
+	query := core.ListTasksQuery{
+		Filter: filter,
+		Sort:   core.TaskSort{Field: core.TaskSortID, Descending: false},
+		Limit:  core.MaxListLimit,
+		Cursor: nil,
+	}
+
+	var ret []*domain.Task
+
+	for {
+		page, err := s.ListTasks(ctx, &query)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, page.Tasks...)
+		if page.NextCursor == nil {
+			return ret, nil
+		}
+
+		query.Cursor = page.NextCursor
+	}
+}
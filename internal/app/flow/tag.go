@@ -0,0 +1,105 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+func (s *Service) CreateTag(ctx context.Context, spec *domain.TagSpec) (*domain.Tag, error) {
+	tag, err := s.repo.CreateTag(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+func (s *Service) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	tags, err := s.repo.ListTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (s *Service) GetTag(ctx context.Context, id domain.TagID) (*domain.Tag, error) {
+	tag, err := s.repo.GetTag(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// UpdateTag 태그의 이름과 색상을 바꿉니다. 태그가 붙은 Task의 버전도 올라갑니다.
+func (s *Service) UpdateTag(ctx context.Context, id domain.TagID, spec *domain.TagSpec) (*domain.Tag, error) {
+	tag, err := s.GetTag(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err = s.repo.UpdateTag(ctx, tag.SetSpec(spec), s.stamp(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// DeleteTag 태그를 삭제하고 태그가 붙은 모든 Task에서 뗍니다. 태그가 붙어 있던 Task의 버전도 올라갑니다.
+func (s *Service) DeleteTag(ctx context.Context, id domain.TagID) error {
+	err := s.repo.DeleteTag(ctx, id, s.stamp(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return nil
+}
+
+// AddTaskTag Task에 태그를 붙입니다. 이미 붙어 있으면 Task를 그대로 반환합니다.
+// expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) AddTaskTag(
+	ctx context.Context,
+	id domain.TaskID,
+	tagID domain.TagID,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.GetTag(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.HasTag(tagID) {
+		return task, nil
+	}
+
+	return s.saveTask(ctx, task.AddTag(tag).Touch(s.stamp(ctx)))
+}
+
+// RemoveTaskTag Task에서 태그를 뗍니다. 붙어 있지 않으면 Task를 그대로 반환합니다.
+// expectedVersion은 UpdateTask와 같습니다.
+func (s *Service) RemoveTaskTag(
+	ctx context.Context,
+	id domain.TaskID,
+	tagID domain.TagID,
+	expectedVersion int,
+) (*domain.Task, error) {
+	task, err := s.getTaskWithVersion(ctx, id, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if !task.HasTag(tagID) {
+		return task, nil
+	}
+
+	return s.saveTask(ctx, task.RemoveTag(tagID).Touch(s.stamp(ctx)))
+}
@@ -0,0 +1,102 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+// ListDeletedTasks 휴지통에 있는 Task를 조회합니다.
+func (s *Service) ListDeletedTasks(ctx context.Context, query *core.ListTasksQuery) (*core.TaskPage, error) {
+	query.Filter.Deleted = true
+
+	return s.ListTasks(ctx, query)
+}
+
+// RestoreTask 휴지통에 있는 Task를 복원합니다. 상위 Task가 휴지통에 있으면 ErrParentNotFound를 반환하므로
+// 상위 Task를 먼저 복원해야 합니다. 상위 Task가 영구 삭제된 Task는 이미 최상위 Task이므로 그대로 복원됩니다.
+func (s *Service) RestoreTask(ctx context.Context, id domain.TaskID) (*domain.Task, error) {
+	task, err := s.getDeletedTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkParent(ctx, "", task.ParentID())
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := s.repo.RestoreTask(ctx, id, s.stamp(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore task: %w", err)
+	}
+
+	return restored, nil
+}
+
+// PurgeTask 휴지통에 있는 Task를 첨부 파일과 함께 영구 삭제합니다. 첨부 파일을 먼저 지우므로 도중에 실패해도
+// Task는 휴지통에 남아 다시 시도할 수 있습니다.
+func (s *Service) PurgeTask(ctx context.Context, id domain.TaskID) error {
+	_, err := s.getDeletedTask(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	attachments, err := s.repo.ListAttachments(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	err = s.purgeAttachments(ctx, attachments)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.PurgeTask(ctx, id, s.stamp(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to purge task: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeExpiredTasks clock 기준으로 휴지통에 retention보다 오래 있었던 Task를 첨부 파일과 함께 영구 삭제하고
+// 그 ID를 반환합니다. 첨부 파일은 Task를 영구 삭제한 뒤 영구 삭제된 Task에 남은 것을 모두 찾아 지우므로,
+// 이전 실행에서 지우지 못한 첨부 파일도 다시 시도합니다.
+func (s *Service) PurgeExpiredTasks(ctx context.Context, retention time.Duration) ([]domain.TaskID, error) {
+	stamp := s.stamp(ctx)
+
+	ids, err := s.repo.PurgeDeletedTasks(ctx, stamp.At().Add(-retention), stamp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge expired tasks: %w", err)
+	}
+
+	err = s.sweepPurgedAttachments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// getDeletedTask 휴지통에 있는 Task를 읽습니다. 없으면 core.ErrTaskNotFound를 반환합니다.
+func (s *Service) getDeletedTask(ctx context.Context, id domain.TaskID) (*domain.Task, error) {
+	var query core.ListTasksQuery
+
+	query.Filter.IDs = []domain.TaskID{id}
+	query.Filter.Deleted = true
+
+	page, err := s.ListTasks(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(page.Tasks) == 0 {
+		return nil, fmt.Errorf("failed to get task: %w", core.ErrTaskNotFound)
+	}
+
+	return page.Tasks[0], nil
+}
@@ -0,0 +1,136 @@
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/app/flow"
+	"github.com/neatflowcv/tasker/internal/pkg/blob"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/fake"
+)
+
+var errBlobUnavailable = errors.New("blob store unavailable")
+
+// failingStore failing이 설정되어 있는 동안 Delete가 실패하는 blob 저장소입니다.
+type failingStore struct {
+	*blob.MemoryStore
+
+	failing atomic.Bool
+}
+
+func (s *failingStore) Delete(ctx context.Context, key string) error {
+	if s.failing.Load() {
+		return errBlobUnavailable
+	}
+
+	return s.MemoryStore.Delete(ctx, key) //nolint:wrapcheck
+}
+
+// TestPurgeTaskBlobFailure 본문을 지우지 못하면 Task와 첨부 파일이 남아 있어 다시 영구 삭제할 수 있는지 확인합니다.
+func TestPurgeTaskBlobFailure(t *testing.T) {
+	t.Parallel()
+
+	store := &failingStore{MemoryStore: blob.NewMemoryStore()}
+	repo := fake.NewRepository()
+	service := flow.NewService(repo, flow.WithBlobStore(store))
+	task, attachment := mustTrashAttachedTask(t, service)
+
+	store.failing.Store(true)
+
+	err := service.PurgeTask(t.Context(), task.ID())
+	if !errors.Is(err, errBlobUnavailable) {
+		t.Fatalf("PurgeTask error = %v, want %v", err, errBlobUnavailable)
+	}
+
+	if _, err = repo.GetAttachment(t.Context(), attachment.ID()); err != nil {
+		t.Fatalf("GetAttachment after a failed purge: %v", err)
+	}
+
+	store.failing.Store(false)
+
+	err = service.PurgeTask(t.Context(), task.ID())
+	if err != nil {
+		t.Fatalf("PurgeTask retry: %v", err)
+	}
+
+	assertAttachmentPurged(t, repo, store, attachment)
+}
+
+// TestPurgeExpiredTasksBlobFailure 본문을 지우지 못한 첨부 파일을 다음 실행에서 다시 지우는지 확인합니다.
+func TestPurgeExpiredTasksBlobFailure(t *testing.T) {
+	t.Parallel()
+
+	store := &failingStore{MemoryStore: blob.NewMemoryStore()}
+	repo := fake.NewRepository()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	service := flow.NewServiceWithClock(repo, func() time.Time { return now }, flow.WithBlobStore(store))
+	task, attachment := mustTrashAttachedTask(t, service)
+
+	now = now.Add(2 * time.Hour)
+
+	store.failing.Store(true)
+
+	_, err := service.PurgeExpiredTasks(t.Context(), time.Hour)
+	if !errors.Is(err, errBlobUnavailable) {
+		t.Fatalf("PurgeExpiredTasks error = %v, want %v", err, errBlobUnavailable)
+	}
+
+	// Task는 이미 영구 삭제되었으므로 다음 실행은 Task 없이 첨부 파일만 지웁니다.
+	store.failing.Store(false)
+
+	purged, err := service.PurgeExpiredTasks(t.Context(), time.Hour)
+	if err != nil || len(purged) != 0 {
+		t.Fatalf("PurgeExpiredTasks retry = %v, %v, want no tasks", purged, err)
+	}
+
+	_, err = repo.GetTask(t.Context(), task.ID())
+	if !errors.Is(err, core.ErrTaskNotFound) {
+		t.Fatalf("GetTask after purge error = %v, want %v", err, core.ErrTaskNotFound)
+	}
+
+	assertAttachmentPurged(t, repo, store, attachment)
+}
+
+// mustTrashAttachedTask 첨부 파일이 하나 있는 Task를 만들어 휴지통으로 옮깁니다.
+func mustTrashAttachedTask(t *testing.T, service *flow.Service) (*domain.Task, *domain.Attachment) {
+	t.Helper()
+
+	task := mustCreateTask(t, service, "report")
+
+	spec, err := domain.NewAttachmentSpec("report.txt")
+	if err != nil {
+		t.Fatalf("NewAttachmentSpec: %v", err)
+	}
+
+	attachment, err := service.CreateAttachment(t.Context(), task.ID(), spec, strings.NewReader("body"), 4)
+	if err != nil {
+		t.Fatalf("CreateAttachment: %v", err)
+	}
+
+	err = service.DeleteTask(t.Context(), task.ID(), flow.SubtaskPolicyOrphan)
+	if err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	return task, attachment
+}
+
+func assertAttachmentPurged(t *testing.T, repo core.Repository, store blob.Store, attachment *domain.Attachment) {
+	t.Helper()
+
+	_, err := repo.GetAttachment(t.Context(), attachment.ID())
+	if !errors.Is(err, core.ErrAttachmentNotFound) {
+		t.Errorf("GetAttachment after purge error = %v, want %v", err, core.ErrAttachmentNotFound)
+	}
+
+	_, err = store.Get(t.Context(), attachment.Blob().Key)
+	if !errors.Is(err, blob.ErrNotFound) {
+		t.Errorf("blob Get after purge error = %v, want %v", err, blob.ErrNotFound)
+	}
+}
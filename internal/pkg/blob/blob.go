@@ -0,0 +1,51 @@
+// Package blob 첨부 파일 본문처럼 큰 바이너리 데이터를 키 단위로 저장하는 저장소를 제공합니다.
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Store blob 저장소입니다. 키는 '/'로 구분된 상대 경로이며 "."이나 ".." 구간, 빈 구간, '\'를 포함할 수 없습니다.
+// Put은 body에서 정확히 size바이트를 읽어 저장하며, 같은 키가 있으면 덮어씁니다.
+// body가 size보다 짧으면 에러를 반환하고 아무것도 저장하지 않습니다.
+// Get은 키가 없으면 ErrNotFound를 반환하며, 호출자가 반환된 본문을 닫아야 합니다.
+// Delete는 키가 없어도 에러를 반환하지 않습니다.
+type Store interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// checkKey 키가 Store의 키 규칙을 따르는지 확인합니다.
+func checkKey(key string) error {
+	if strings.ContainsRune(key, '\\') {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+
+	for segment := range strings.SplitSeq(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+
+	return nil
+}
+
+// checkRequest ctx가 취소되지 않았고 키가 규칙을 따르는지 확인합니다.
+func checkRequest(ctx context.Context, key string) error {
+	err := ctx.Err()
+	if err != nil {
+		return fmt.Errorf("failed to access blob: %w", err)
+	}
+
+	return checkKey(key)
+}
@@ -0,0 +1,154 @@
+package blob_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/tasker/internal/pkg/blob"
+)
+
+// runStoreTests 모든 blob.Store 구현체가 지켜야 하는 동작을 검사합니다.
+func runStoreTests(t *testing.T, store blob.Store) {
+	t.Helper()
+
+	t.Run("PutGetDelete", func(t *testing.T) {
+		t.Parallel()
+
+		testPutGetDelete(t, store)
+	})
+	t.Run("Overwrite", func(t *testing.T) {
+		t.Parallel()
+
+		testOverwrite(t, store)
+	})
+	t.Run("ShortBody", func(t *testing.T) {
+		t.Parallel()
+
+		testShortBody(t, store)
+	})
+	t.Run("InvalidKey", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidKey(t, store)
+	})
+}
+
+func testPutGetDelete(t *testing.T, store blob.Store) {
+	t.Helper()
+
+	ctx := t.Context()
+	key := "tasks/01JZ3Q/screen shot+1.png"
+
+	mustPut(t, store, key, "hello, blob")
+
+	if got := mustGet(t, store, key); got != "hello, blob" {
+		t.Errorf("Get = %q, want %q", got, "hello, blob")
+	}
+
+	mustPut(t, store, "tasks/01JZ3Q/empty", "")
+
+	if got := mustGet(t, store, "tasks/01JZ3Q/empty"); got != "" {
+		t.Errorf("Get(empty) = %q, want empty", got)
+	}
+
+	err := store.Delete(ctx, key)
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	_, err = store.Get(ctx, key)
+	if !errors.Is(err, blob.ErrNotFound) {
+		t.Errorf("Get after Delete error = %v, want %v", err, blob.ErrNotFound)
+	}
+
+	err = store.Delete(ctx, key)
+	if err != nil {
+		t.Errorf("Delete missing key: %v", err)
+	}
+}
+
+func testOverwrite(t *testing.T, store blob.Store) {
+	t.Helper()
+
+	mustPut(t, store, "overwrite/key", "first")
+	mustPut(t, store, "overwrite/key", "second")
+
+	if got := mustGet(t, store, "overwrite/key"); got != "second" {
+		t.Errorf("Get = %q, want %q", got, "second")
+	}
+}
+
+func testShortBody(t *testing.T, store blob.Store) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	err := store.Put(ctx, "short/key", strings.NewReader("abc"), 10, "text/plain")
+	if err == nil {
+		t.Fatal("Put with short body succeeded")
+	}
+
+	_, err = store.Get(ctx, "short/key")
+	if !errors.Is(err, blob.ErrNotFound) {
+		t.Errorf("Get after failed Put error = %v, want %v", err, blob.ErrNotFound)
+	}
+}
+
+func testInvalidKey(t *testing.T, store blob.Store) {
+	t.Helper()
+
+	for _, key := range []string{"", "/abs", "trailing/", "a//b", "../escape", "a/./b", `a\b`} {
+		err := store.Put(t.Context(), key, strings.NewReader("x"), 1, "text/plain")
+		if !errors.Is(err, blob.ErrInvalidKey) {
+			t.Errorf("Put(%q) error = %v, want %v", key, err, blob.ErrInvalidKey)
+		}
+	}
+}
+
+func mustPut(t *testing.T, store blob.Store, key, body string) {
+	t.Helper()
+
+	err := store.Put(t.Context(), key, strings.NewReader(body), int64(len(body)), "text/plain")
+	if err != nil {
+		t.Fatalf("Put(%q): %v", key, err)
+	}
+}
+
+func mustGet(t *testing.T, store blob.Store, key string) string {
+	t.Helper()
+
+	reader, err := store.Get(t.Context(), key)
+	if err != nil {
+		t.Fatalf("Get(%q): %v", key, err)
+	}
+
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll(%q): %v", key, err)
+	}
+
+	return string(data)
+}
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	runStoreTests(t, blob.NewMemoryStore())
+}
+
+func TestLocalStore(t *testing.T) {
+	t.Parallel()
+
+	store, err := blob.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+
+	runStoreTests(t, store)
+}
@@ -0,0 +1,11 @@
+package blob
+
+import (
+	"net/http"
+	"time"
+)
+
+// SignV4 테스트에서 서명을 AWS 문서의 예제와 비교할 수 있도록 signV4를 노출합니다.
+func SignV4(req *http.Request, config S3Config, now time.Time) {
+	signV4(req, config, now)
+}
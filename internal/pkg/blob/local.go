@@ -0,0 +1,113 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+var _ Store = (*LocalStore)(nil)
+
+const localDirPerm = 0o750
+
+// LocalStore 로컬 파일 시스템의 root 디렉토리 아래에 키를 경로로 하여 blob을 저장합니다.
+// 여러 replica가 같은 파일을 보려면 root가 공유 볼륨이어야 합니다.
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore root 디렉토리를 만들고 LocalStore를 생성합니다.
+func NewLocalStore(root string) (*LocalStore, error) {
+	err := os.MkdirAll(root, localDirPerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	return &LocalStore{root: root}, nil
+}
+
+// Put implements Store. 같은 디렉토리의 임시 파일에 쓴 뒤 이름을 바꾸므로 읽는 쪽은 완성된 파일만 봅니다.
+func (s *LocalStore) Put(ctx context.Context, key string, body io.Reader, size int64, _ string) error {
+	err := checkRequest(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	path := s.path(key)
+
+	err = os.MkdirAll(filepath.Dir(path), localDirPerm)
+	if err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create blob file: %w", err)
+	}
+
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+
+	_, err = io.CopyN(file, body, size)
+	if err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	return nil
+}
+
+// Get implements Store.
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	err := checkRequest(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete implements Store.
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	err := checkRequest(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(s.path(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	return nil
+}
+
+// path 키에 해당하는 파일 경로입니다. checkKey를 통과한 키는 root 밖을 가리킬 수 없습니다.
+func (s *LocalStore) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
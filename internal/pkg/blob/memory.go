@@ -0,0 +1,80 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+var _ Store = (*MemoryStore)(nil)
+
+// MemoryStore 메모리에 blob을 저장합니다. 프로세스가 종료되면 내용이 사라지므로 테스트와 단일 노드 개발용입니다.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	blobs map[string][]byte
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		mu:    sync.RWMutex{},
+		blobs: make(map[string][]byte),
+	}
+}
+
+// Put implements Store.
+func (s *MemoryStore) Put(ctx context.Context, key string, body io.Reader, size int64, _ string) error {
+	err := checkRequest(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	_, err = io.CopyN(&buf, body, size)
+	if err != nil {
+		return fmt.Errorf("failed to read blob: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blobs[key] = buf.Bytes()
+
+	return nil
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	err := checkRequest(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, exists := s.blobs[key]
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	// 저장된 슬라이스는 덮어쓸 때 통째로 교체되므로 복사하지 않고 읽어도 됩니다.
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	err := checkRequest(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.blobs, key)
+
+	return nil
+}
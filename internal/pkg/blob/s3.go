@@ -0,0 +1,284 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+var _ Store = (*S3Store)(nil)
+
+var (
+	ErrInvalidS3Endpoint = errors.New("invalid s3 endpoint")
+	errS3Response        = errors.New("unexpected s3 response")
+)
+
+const (
+	// s3UnsignedPayload 본문을 미리 읽어 해시하지 않고 스트리밍으로 올리기 위해 서명에서 본문을 제외합니다.
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3Service         = "s3"
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3DateFormat      = "20060102"
+	s3TimeFormat      = "20060102T150405Z"
+
+	// s3ErrorBodyLimit 에러 응답에서 원인을 읽을 때 읽는 최대 바이트 수입니다.
+	s3ErrorBodyLimit = 4096
+)
+
+// S3Config S3 호환 저장소의 접속 정보입니다.
+type S3Config struct {
+	// Endpoint 저장소의 URL입니다. 예: https://s3.ap-northeast-2.amazonaws.com, http://minio:9000
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3Store S3 호환 저장소의 버킷에 blob을 저장합니다. 요청은 path-style URL과 Signature Version 4로 서명합니다.
+type S3Store struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3Store config로 S3Store를 생성합니다. 버킷은 미리 만들어져 있어야 합니다.
+func NewS3Store(config S3Config) (*S3Store, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidS3Endpoint, config.Endpoint)
+	}
+
+	return &S3Store{
+		config:   config,
+		endpoint: endpoint,
+		client:   &http.Client{}, //nolint:exhaustruct
+		now:      time.Now,
+	}, nil
+}
+
+// Put implements Store. 본문은 Content-Length를 지정한 한 번의 PUT으로 올립니다.
+func (s *S3Store) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, io.LimitReader(body, size))
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("failed to put blob: %w", err)
+	}
+
+	_ = resp.Body.Close()
+
+	return nil
+}
+
+// Get implements Store.
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blob: %w", err)
+	}
+
+	return resp.Body, nil
+}
+
+// Delete implements Store. S3는 없는 키를 삭제해도 성공으로 응답합니다.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	_ = resp.Body.Close()
+
+	return nil
+}
+
+func (s *S3Store) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	err := checkRequest(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	objectURL := *s.endpoint
+	objectURL.RawPath = strings.TrimSuffix(s.endpoint.EscapedPath(), "/") + "/" + s3Escape(s.config.Bucket, false) +
+		"/" + s3Escape(key, true)
+
+	objectURL.Path, err = url.PathUnescape(objectURL.RawPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build s3 request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build s3 request: %w", err)
+	}
+
+	return req, nil
+}
+
+// do 요청에 서명하여 보냅니다. 2xx가 아닌 응답은 본문을 닫고 에러로 반환하며, 404는 ErrNotFound입니다.
+func (s *S3Store) do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+	signV4(req, s.config, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return resp, nil
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	var s3Err struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+
+	_ = xml.NewDecoder(io.LimitReader(resp.Body, s3ErrorBodyLimit)).Decode(&s3Err)
+
+	return nil, fmt.Errorf("%w: %s: %s %s", errS3Response, resp.Status, s3Err.Code, s3Err.Message)
+}
+
+// signV4 요청에 AWS Signature Version 4 Authorization 헤더를 추가합니다.
+// Host와 요청에 이미 설정된 헤더를 모두 서명하며, 본문 해시는 X-Amz-Content-Sha256 헤더 값을 사용합니다.
+func signV4(req *http.Request, config S3Config, now time.Time) {
+	now = now.UTC()
+	req.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+
+	names := slices.Sorted(maps.Keys(headers))
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalPath := req.URL.EscapedPath()
+	if canonicalPath == "" {
+		canonicalPath = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalPath,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+
+	scope := now.Format(s3DateFormat) + "/" + config.Region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{s3Algorithm, now.Format(s3TimeFormat), scope, hashHex(canonicalRequest)}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+config.SecretAccessKey), now.Format(s3DateFormat))
+	key = hmacSHA256(key, config.Region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, config.AccessKeyID, scope, signedHeaders, hex.EncodeToString(hmacSHA256(key, stringToSign))))
+}
+
+func canonicalQuery(query url.Values) string {
+	params := make([]string, 0, len(query))
+
+	for name, values := range query {
+		for _, value := range values {
+			params = append(params, s3Escape(name, false)+"="+s3Escape(value, false))
+		}
+	}
+
+	slices.Sort(params)
+
+	return strings.Join(params, "&")
+}
+
+// s3Escape SigV4 규칙에 따라 비예약 문자를 제외한 모든 바이트를 %XX로 인코딩합니다. keepSlash가 true이면 '/'는 그대로 둡니다.
+func s3Escape(value string, keepSlash bool) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var ret strings.Builder
+
+	for i := range len(value) {
+		char := value[i]
+
+		switch {
+		case 'A' <= char && char <= 'Z', 'a' <= char && char <= 'z', '0' <= char && char <= '9',
+			char == '-', char == '.', char == '_', char == '~', keepSlash && char == '/':
+			ret.WriteByte(char)
+		default:
+			ret.WriteByte('%')
+			ret.WriteByte(hexDigits[char>>4])
+			ret.WriteByte(hexDigits[char&0x0f])
+		}
+	}
+
+	return ret.String()
+}
+
+func hashHex(value string) string {
+	sum := sha256.Sum256([]byte(value))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, value string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+
+	return mac.Sum(nil)
+}
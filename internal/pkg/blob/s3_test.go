@@ -0,0 +1,134 @@
+package blob_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/neatflowcv/tasker/internal/pkg/blob"
+)
+
+const (
+	testBucket      = "tasker"
+	testAccessKeyID = "test-access-key"
+)
+
+// fakeS3 S3Store가 사용하는 path-style PUT, GET, DELETE Object만 흉내 내는 S3 호환 서버입니다.
+// 서명 값은 검증하지 않지만 서명에 필요한 헤더와 자격 증명이 올바른지 확인합니다.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if !f.authorized(req) {
+		resp.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(resp, "<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>")
+
+		return
+	}
+
+	key, found := strings.CutPrefix(req.URL.Path, "/"+testBucket+"/")
+	if !found {
+		resp.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(resp, "<Error><Code>NoSuchBucket</Code></Error>")
+
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch req.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(req.Body)
+		if err != nil || int64(len(data)) != req.ContentLength {
+			resp.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		f.objects[key] = string(data)
+	case http.MethodGet:
+		data, exists := f.objects[key]
+		if !exists {
+			resp.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(resp, "<Error><Code>NoSuchKey</Code></Error>")
+
+			return
+		}
+
+		_, _ = io.WriteString(resp, data)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		resp.WriteHeader(http.StatusNoContent)
+	default:
+		resp.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) authorized(req *http.Request) bool {
+	auth := req.Header.Get("Authorization")
+
+	credential, rest, _ := strings.Cut(auth, ", SignedHeaders=")
+	signedHeaders, _, _ := strings.Cut(rest, ", Signature=")
+
+	return strings.HasPrefix(credential, "AWS4-HMAC-SHA256 Credential="+testAccessKeyID+"/") &&
+		strings.HasSuffix(credential, "/us-east-1/s3/aws4_request") &&
+		strings.Contains(signedHeaders, "host;x-amz-content-sha256;x-amz-date") &&
+		req.Header.Get("X-Amz-Content-Sha256") == "UNSIGNED-PAYLOAD"
+}
+
+func newS3Store(t *testing.T, accessKeyID string) *blob.S3Store {
+	t.Helper()
+
+	server := httptest.NewServer(&fakeS3{mu: sync.Mutex{}, objects: make(map[string]string)})
+	t.Cleanup(server.Close)
+
+	store, err := blob.NewS3Store(blob.S3Config{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          testBucket,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: "test-secret",
+	})
+	if err != nil {
+		t.Fatalf("NewS3Store: %v", err)
+	}
+
+	return store
+}
+
+func TestS3Store(t *testing.T) {
+	t.Parallel()
+
+	runStoreTests(t, newS3Store(t, testAccessKeyID))
+}
+
+func TestS3StoreAccessDenied(t *testing.T) {
+	t.Parallel()
+
+	store := newS3Store(t, "wrong-key")
+
+	err := store.Put(t.Context(), "denied", strings.NewReader("x"), 1, "text/plain")
+	if err == nil || !strings.Contains(err.Error(), "AccessDenied") {
+		t.Errorf("Put error = %v, want AccessDenied", err)
+	}
+}
+
+func TestNewS3StoreInvalidEndpoint(t *testing.T) {
+	t.Parallel()
+
+	for _, endpoint := range []string{"", "minio:9000", "ftp://minio"} {
+		_, err := blob.NewS3Store(blob.S3Config{ //nolint:exhaustruct
+			Endpoint: endpoint,
+		})
+		if !errors.Is(err, blob.ErrInvalidS3Endpoint) {
+			t.Errorf("NewS3Store(%q) error = %v, want %v", endpoint, err, blob.ErrInvalidS3Endpoint)
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var ErrInvalidConfig = errors.New("invalid config")
+
+type StorageBackend string
+
+const (
+	StorageMemory   StorageBackend = "memory"
+	StoragePostgres StorageBackend = "postgres"
+)
+
+// BlobBackend 첨부 파일 본문을 저장할 blob 저장소 종류입니다.
+type BlobBackend string
+
+const (
+	BlobMemory BlobBackend = "memory"
+	BlobLocal  BlobBackend = "local"
+	BlobS3     BlobBackend = "s3"
+)
+
+const (
+	defaultPort        = 8080
+	defaultGinMode     = gin.DebugMode
+	defaultAPIBasePath = "/tasker/v1"
+	defaultLanguage    = "en"
+	defaultDBPort      = 5432
+	defaultDBSSLMode   = "disable"
+
+	defaultRequestTimeout = 30 * time.Second
+
+	defaultTrashRetention     = 30 * 24 * time.Hour
+	defaultTrashPurgeInterval = time.Hour
+
+	defaultAttachmentMaxSize = 10 << 20
+	defaultS3Region          = "us-east-1"
+)
+
+type Config struct {
+	Port           int
+	GinMode        string
+	APIBasePath    string
+	RequestTimeout time.Duration
+	// DefaultLanguage Accept-Language 협상에 실패했을 때 사용할 응답 언어입니다.
+	DefaultLanguage string
+	Storage         StorageBackend
+	// SnapshotPath memory 백엔드의 상태를 저장할 JSON 파일 경로입니다. 비어 있으면 저장하지 않습니다.
+	SnapshotPath string
+	Database     DatabaseConfig
+	Trash        TrashConfig
+	Attachment   AttachmentConfig
+}
+
+// AttachmentConfig 첨부 파일 설정입니다.
+type AttachmentConfig struct {
+	// MaxSize 첨부 파일 하나의 최대 크기(바이트)입니다.
+	MaxSize int64
+	Backend BlobBackend
+	// LocalPath local 백엔드가 파일을 저장할 디렉토리입니다.
+	LocalPath string
+	S3        S3Config
+}
+
+// S3Config s3 백엔드의 S3 호환 저장소 접속 정보입니다.
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// TrashConfig 휴지통 보관 정책입니다.
+type TrashConfig struct {
+	// Retention 삭제된 Task를 휴지통에 보관하는 기간입니다. 0이면 자동으로 영구 삭제하지 않습니다.
+	Retention time.Duration
+	// PurgeInterval 보관 기간이 지난 Task를 영구 삭제하는 작업의 실행 주기입니다.
+	PurgeInterval time.Duration
+}
+
+type DatabaseConfig struct {
+	Host     string
+	Port     int
+	Name     string
+	User     string
+	Password string
+	SSLMode  string
+}
+
+// DSN PostgreSQL 접속 문자열을 반환합니다.
+func (c *DatabaseConfig) DSN() string {
+	dsn := url.URL{ //nolint:exhaustruct
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Password),
+		Host:     net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+		Path:     "/" + c.Name,
+		RawQuery: url.Values{"sslmode": []string{c.SSLMode}}.Encode(),
+	}
+
+	return dsn.String()
+}
+
+// Load 환경 변수에서 설정을 읽고 검증합니다.
+func Load() (*Config, error) {
+	port, err := parsePort("PORT", getenv("PORT", strconv.Itoa(defaultPort)))
+	if err != nil {
+		return nil, err
+	}
+
+	dbPort, err := parsePort("DB_PORT", getenv("DB_PORT", strconv.Itoa(defaultDBPort)))
+	if err != nil {
+		return nil, err
+	}
+
+	requestTimeout, err := time.ParseDuration(getenv("REQUEST_TIMEOUT", defaultRequestTimeout.String()))
+	if err != nil || requestTimeout <= 0 {
+		return nil, fmt.Errorf("%w: REQUEST_TIMEOUT must be a positive duration: got %q",
+			ErrInvalidConfig, getenv("REQUEST_TIMEOUT", ""))
+	}
+
+	trash, err := loadTrashConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := loadAttachmentConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	dbHost := getenv("DB_HOST", "")
+
+	// STORAGE_BACKEND가 없으면 DB_HOST 유무로 백엔드를 결정합니다.
+	defaultStorage := StorageMemory
+	if dbHost != "" {
+		defaultStorage = StoragePostgres
+	}
+
+	cfg := &Config{
+		Port:            port,
+		GinMode:         getenv("GIN_MODE", defaultGinMode),
+		APIBasePath:     getenv("API_BASE_PATH", defaultAPIBasePath),
+		RequestTimeout:  requestTimeout,
+		DefaultLanguage: getenv("DEFAULT_LANGUAGE", defaultLanguage),
+		Storage:         StorageBackend(getenv("STORAGE_BACKEND", string(defaultStorage))),
+		SnapshotPath:    getenv("MEMORY_SNAPSHOT_PATH", ""),
+		Database: DatabaseConfig{
+			Host:     dbHost,
+			Port:     dbPort,
+			Name:     getenv("DB_NAME", ""),
+			User:     getenv("DB_USER", ""),
+			Password: getenv("DB_PASSWORD", ""),
+			SSLMode:  getenv("DB_SSLMODE", defaultDBSSLMode),
+		},
+		Trash:      trash,
+		Attachment: attachment,
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Validate 설정 값의 유효성을 검사합니다.
+func (c *Config) Validate() error {
+	switch c.GinMode {
+	case gin.DebugMode, gin.ReleaseMode, gin.TestMode:
+	default:
+		return fmt.Errorf("%w: GIN_MODE must be one of debug, release, test: got %q", ErrInvalidConfig, c.GinMode)
+	}
+
+	if !strings.HasPrefix(c.APIBasePath, "/") {
+		return fmt.Errorf("%w: API_BASE_PATH must start with '/': got %q", ErrInvalidConfig, c.APIBasePath)
+	}
+
+	err := c.Attachment.validate()
+	if err != nil {
+		return err
+	}
+
+	switch c.Storage {
+	case StorageMemory:
+		return nil
+	case StoragePostgres:
+		err = c.Database.validate()
+		if err != nil {
+			return err
+		}
+
+		// 첨부 파일 메타데이터는 모든 replica가 공유하므로 본문도 공유되고 재시작 후에도 남는 곳에 저장해야 합니다.
+		if c.Attachment.Backend == BlobMemory {
+			return fmt.Errorf("%w: postgres storage requires BLOB_BACKEND local or s3: got %q",
+				ErrInvalidConfig, c.Attachment.Backend)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: STORAGE_BACKEND must be one of memory, postgres: got %q", ErrInvalidConfig, c.Storage)
+	}
+}
+
+func (c *AttachmentConfig) validate() error {
+	switch c.Backend {
+	case BlobMemory:
+		return nil
+	case BlobLocal:
+		if c.LocalPath == "" {
+			return fmt.Errorf("%w: local blob storage requires BLOB_LOCAL_PATH", ErrInvalidConfig)
+		}
+
+		return nil
+	case BlobS3:
+		return c.S3.validate()
+	default:
+		return fmt.Errorf("%w: BLOB_BACKEND must be one of memory, local, s3: got %q", ErrInvalidConfig, c.Backend)
+	}
+}
+
+func (c *S3Config) validate() error {
+	var missing []string
+
+	for key, value := range map[string]string{
+		"S3_ENDPOINT":          c.Endpoint,
+		"S3_BUCKET":            c.Bucket,
+		"S3_ACCESS_KEY_ID":     c.AccessKeyID,
+		"S3_SECRET_ACCESS_KEY": c.SecretAccessKey,
+	} {
+		if value == "" {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+
+		return fmt.Errorf("%w: s3 blob storage requires %s", ErrInvalidConfig, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func (c *DatabaseConfig) validate() error {
+	var missing []string
+
+	for key, value := range map[string]string{
+		"DB_HOST":     c.Host,
+		"DB_NAME":     c.Name,
+		"DB_USER":     c.User,
+		"DB_PASSWORD": c.Password,
+	} {
+		if value == "" {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+
+		return fmt.Errorf("%w: postgres storage requires %s", ErrInvalidConfig, strings.Join(missing, ", "))
+	}
+
+	switch c.SSLMode {
+	case "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+		return nil
+	default:
+		return fmt.Errorf("%w: DB_SSLMODE is not a valid sslmode: got %q", ErrInvalidConfig, c.SSLMode)
+	}
+}
+
+func loadTrashConfig() (TrashConfig, error) {
+	retention, err := time.ParseDuration(getenv("TRASH_RETENTION", defaultTrashRetention.String()))
+	if err != nil || retention < 0 {
+		return TrashConfig{}, fmt.Errorf("%w: TRASH_RETENTION must be a non-negative duration: got %q",
+			ErrInvalidConfig, getenv("TRASH_RETENTION", ""))
+	}
+
+	interval, err := time.ParseDuration(getenv("TRASH_PURGE_INTERVAL", defaultTrashPurgeInterval.String()))
+	if err != nil || interval <= 0 {
+		return TrashConfig{}, fmt.Errorf("%w: TRASH_PURGE_INTERVAL must be a positive duration: got %q",
+			ErrInvalidConfig, getenv("TRASH_PURGE_INTERVAL", ""))
+	}
+
+	return TrashConfig{Retention: retention, PurgeInterval: interval}, nil
+}
+
+func loadAttachmentConfig() (AttachmentConfig, error) {
+	maxSize, err := strconv.ParseInt(getenv("ATTACHMENT_MAX_SIZE", strconv.Itoa(defaultAttachmentMaxSize)), 10, 64)
+	if err != nil || maxSize <= 0 {
+		return AttachmentConfig{}, fmt.Errorf("%w: ATTACHMENT_MAX_SIZE must be a positive number of bytes: got %q",
+			ErrInvalidConfig, getenv("ATTACHMENT_MAX_SIZE", ""))
+	}
+
+	localPath := getenv("BLOB_LOCAL_PATH", "")
+	bucket := getenv("S3_BUCKET", "")
+
+	// BLOB_BACKEND가 없으면 S3_BUCKET, BLOB_LOCAL_PATH 유무로 백엔드를 결정합니다.
+	defaultBackend := BlobMemory
+
+	switch {
+	case bucket != "":
+		defaultBackend = BlobS3
+	case localPath != "":
+		defaultBackend = BlobLocal
+	}
+
+	return AttachmentConfig{
+		MaxSize:   maxSize,
+		Backend:   BlobBackend(getenv("BLOB_BACKEND", string(defaultBackend))),
+		LocalPath: localPath,
+		S3: S3Config{
+			Endpoint:        getenv("S3_ENDPOINT", ""),
+			Region:          getenv("S3_REGION", defaultS3Region),
+			Bucket:          bucket,
+			AccessKeyID:     getenv("S3_ACCESS_KEY_ID", ""),
+			SecretAccessKey: getenv("S3_SECRET_ACCESS_KEY", ""),
+		},
+	}, nil
+}
+
+func parsePort(key, value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("%w: %s must be a port number between 1 and 65535: got %q", ErrInvalidConfig, key, value)
+	}
+
+	return port, nil
+}
+
+func getenv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return fallback
+}
@@ -0,0 +1,92 @@
+package domain
+
+type AttachmentID string
+
+// AttachmentSpec 첨부 파일 업로드 입력입니다. 이름은 앞뒤 공백이 제거됩니다.
+type AttachmentSpec struct {
+	name string
+}
+
+// NewAttachmentSpec 파일 이름을 검증하여 AttachmentSpec을 생성합니다. 규칙을 위반하면 *ValidationError를 반환합니다.
+func NewAttachmentSpec(name string) (*AttachmentSpec, error) {
+	var check validator
+
+	name = check.fileName(name)
+
+	err := check.err()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AttachmentSpec{name: name}, nil
+}
+
+func (s *AttachmentSpec) Name() string {
+	return s.name
+}
+
+// AttachmentBlob blob 저장소에 저장된 첨부 파일 본문의 정보입니다.
+type AttachmentBlob struct {
+	// Key blob 저장소에서 본문을 찾는 키입니다.
+	Key string
+	// ContentType 본문의 앞부분으로 판별한 MIME 타입입니다.
+	ContentType string
+	Size        int64
+	// Checksum 본문의 SHA-256 해시를 16진수로 나타낸 값입니다.
+	Checksum string
+}
+
+// AttachmentParams 저장소가 저장된 값으로 Attachment를 복원할 때 NewAttachment에 전달하는 필드입니다.
+type AttachmentParams struct {
+	ID      AttachmentID
+	TaskID  TaskID
+	Name    string
+	Blob    AttachmentBlob
+	Created Stamp
+}
+
+// Attachment Task에 첨부된 파일의 메타데이터입니다. 본문은 Blob().Key로 blob 저장소에서 읽습니다.
+type Attachment struct {
+	id      AttachmentID
+	taskID  TaskID
+	name    string
+	blob    AttachmentBlob
+	created Stamp
+}
+
+func NewAttachment(params AttachmentParams) *Attachment {
+	return &Attachment{
+		id:      params.ID,
+		taskID:  params.TaskID,
+		name:    params.Name,
+		blob:    params.Blob,
+		created: params.Created,
+	}
+}
+
+func (a *Attachment) ID() AttachmentID {
+	return a.id
+}
+
+func (a *Attachment) TaskID() TaskID {
+	return a.taskID
+}
+
+func (a *Attachment) Name() string {
+	return a.name
+}
+
+func (a *Attachment) Blob() AttachmentBlob {
+	return a.blob
+}
+
+// Created 업로드 시각과 업로드한 주체입니다.
+func (a *Attachment) Created() Stamp {
+	return a.created
+}
+
+func (a *Attachment) Clone() *Attachment {
+	ret := *a
+
+	return &ret
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// AuditAction 감사 기록에 남는 Task 변경의 종류입니다.
+type AuditAction string
+
+const (
+	AuditActionCreate  AuditAction = "create"
+	AuditActionUpdate  AuditAction = "update"
+	AuditActionDelete  AuditAction = "delete"
+	AuditActionRestore AuditAction = "restore"
+	AuditActionPurge   AuditAction = "purge"
+)
+
+type AuditEntryID string
+
+// AuditEntryParams 저장소가 저장된 값으로 AuditEntry를 복원할 때 RestoreAuditEntry에 전달하는 필드입니다.
+type AuditEntryParams struct {
+	ID      AuditEntryID
+	TaskID  TaskID
+	Action  AuditAction
+	Stamp   Stamp
+	Changes []FieldChange
+}
+
+// AuditEntry Task 변경 한 건의 감사 기록입니다.
+type AuditEntry struct {
+	id      AuditEntryID
+	taskID  TaskID
+	action  AuditAction
+	stamp   Stamp
+	changes []FieldChange
+}
+
+// NewAuditEntry before에서 after로의 변경을 기록하는 AuditEntry를 만듭니다. 생성은 before가 nil입니다.
+// ID는 저장소가 부여합니다.
+func NewAuditEntry(action AuditAction, before, after *Task, stamp Stamp) AuditEntry {
+	return AuditEntry{
+		id:      "",
+		taskID:  after.id,
+		action:  action,
+		stamp:   stamp,
+		changes: DiffTasks(before, after),
+	}
+}
+
+// NewPurgeAuditEntry 영구 삭제된 Task의 AuditEntry를 만듭니다. 삭제 전의 내용은 이전 기록에 남아 있으므로
+// 변경 내용은 비워 둡니다.
+func NewPurgeAuditEntry(id TaskID, stamp Stamp) AuditEntry {
+	return AuditEntry{id: "", taskID: id, action: AuditActionPurge, stamp: stamp, changes: nil}
+}
+
+// RestoreAuditEntry 저장소가 저장된 값으로 AuditEntry를 복원합니다.
+func RestoreAuditEntry(params AuditEntryParams) AuditEntry {
+	return AuditEntry{
+		id:      params.ID,
+		taskID:  params.TaskID,
+		action:  params.Action,
+		stamp:   params.Stamp,
+		changes: slices.Clone(params.Changes),
+	}
+}
+
+func (e AuditEntry) ID() AuditEntryID {
+	return e.id
+}
+
+func (e AuditEntry) TaskID() TaskID {
+	return e.taskID
+}
+
+func (e AuditEntry) Action() AuditAction {
+	return e.action
+}
+
+// Stamp 변경 시각과 변경한 주체입니다.
+func (e AuditEntry) Stamp() Stamp {
+	return e.stamp
+}
+
+// Changes 값이 바뀐 필드를 고정된 순서로 반환합니다.
+func (e AuditEntry) Changes() []FieldChange {
+	return slices.Clone(e.changes)
+}
+
+// WithID ID를 id로 바꾼 새 AuditEntry를 반환합니다. 저장소가 기록을 추가할 때 사용합니다.
+func (e AuditEntry) WithID(id AuditEntryID) AuditEntry {
+	e.id = id
+
+	return e
+}
+
+// FieldChange 필드 하나의 변경 전후 값입니다. 값이 없으면 빈 문자열입니다.
+type FieldChange struct {
+	field  string
+	before string
+	after  string
+}
+
+func NewFieldChange(field, before, after string) FieldChange {
+	return FieldChange{field: field, before: before, after: after}
+}
+
+func (c FieldChange) Field() string {
+	return c.field
+}
+
+func (c FieldChange) Before() string {
+	return c.before
+}
+
+func (c FieldChange) After() string {
+	return c.after
+}
+
+// DiffTasks 두 Task에서 값이 다른 필드를 고정된 순서로 반환합니다. nil은 모든 필드가 비어 있는 Task로 취급합니다.
+// 버전과 생성·수정 정보는 기록 자체에 담기므로 비교하지 않습니다.
+func DiffTasks(before, after *Task) []FieldChange {
+	beforeFields := before.auditFields()
+	afterFields := after.auditFields()
+
+	var changes []FieldChange
+
+	for i, field := range beforeFields {
+		if field[1] != afterFields[i][1] {
+			changes = append(changes, NewFieldChange(field[0], field[1], afterFields[i][1]))
+		}
+	}
+
+	return changes
+}
+
+// auditFields 감사 기록에서 비교할 (필드 이름, 값) 목록입니다. 시각은 UTC RFC 3339 형식입니다.
+func (t *Task) auditFields() [][2]string {
+	if t == nil {
+		t = &Task{} //nolint:exhaustruct
+	}
+
+	tags := make([]string, len(t.tags))
+	for i, tag := range t.tags {
+		tags[i] = tag.name
+	}
+
+	return [][2]string{
+		{"title", t.title},
+		{"description", t.description},
+		{"status", string(t.status)},
+		{"priority", string(t.priority)},
+		{"due_date", formatAuditTime(t.dueDate)},
+		{"parent_id", string(t.parentID)},
+		{"project_id", string(t.projectID)},
+		{"key", t.key},
+		{"rank", t.rank},
+		{"tags", strings.Join(tags, ",")},
+		{"checklist", formatChecklist(t.checklist)},
+		{"deleted_at", formatAuditTime(t.deletedAt)},
+	}
+}
+
+func formatAuditTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MaxBoardColumns 프로젝트 보드에 둘 수 있는 열의 최대 개수입니다.
+const MaxBoardColumns = 20
+
+// BoardColumn 프로젝트 보드의 열입니다. 상태가 Statuses 중 하나인 Task가 이 열에 놓이며,
+// 다른 열에서 옮겨 오면 Task의 상태가 Statuses 중 전이할 수 있는 첫 번째 상태로 바뀝니다.
+type BoardColumn struct {
+	key      string
+	name     string
+	statuses []TaskStatus
+}
+
+// NewBoardColumn 열 하나를 만듭니다. 입력으로 받은 열은 NewBoardColumns로 검증해야 합니다.
+func NewBoardColumn(key, name string, statuses []TaskStatus) BoardColumn {
+	return BoardColumn{key: key, name: name, statuses: slices.Clone(statuses)}
+}
+
+func (c BoardColumn) Key() string {
+	return c.key
+}
+
+func (c BoardColumn) Name() string {
+	return c.name
+}
+
+func (c BoardColumn) Statuses() []TaskStatus {
+	return slices.Clone(c.statuses)
+}
+
+// DefaultBoardColumns 열을 설정하지 않은 프로젝트의 보드로, 상태마다 열이 하나씩 있습니다.
+func DefaultBoardColumns() []BoardColumn {
+	return []BoardColumn{
+		{key: "open", name: "Open", statuses: []TaskStatus{TaskStatusOpen}},
+		{key: "in_progress", name: "In Progress", statuses: []TaskStatus{TaskStatusInProgress}},
+		{key: "blocked", name: "Blocked", statuses: []TaskStatus{TaskStatusBlocked}},
+		{key: "done", name: "Done", statuses: []TaskStatus{TaskStatusDone}},
+		{key: "cancelled", name: "Cancelled", statuses: []TaskStatus{TaskStatusCancelled}},
+	}
+}
+
+// NewBoardColumns 보드 열 설정을 검증하여 반환합니다. 키는 소문자로 정규화되고 이름의 앞뒤 공백은 제거됩니다.
+// 열이 없거나 MaxBoardColumns개를 넘거나, 키나 상태가 다른 열과 겹치면
+// columns[0].key와 같이 열의 위치를 담은 필드의 *ValidationError를 반환합니다.
+func NewBoardColumns(columns []BoardColumn) ([]BoardColumn, error) {
+	var check validator
+
+	switch {
+	case len(columns) == 0:
+		check.add("columns", ViolationRequired, "")
+	case len(columns) > MaxBoardColumns:
+		check.add("columns", ViolationMaxItems, strconv.Itoa(MaxBoardColumns))
+	}
+
+	ret := make([]BoardColumn, len(columns))
+
+	for index, column := range columns {
+		field := fmt.Sprintf("columns[%d]", index)
+		key := check.columnKey(field+".key", column.key)
+
+		if slices.ContainsFunc(ret[:index], func(other BoardColumn) bool { return other.key == key }) {
+			check.add(field+".key", ViolationDuplicate, "")
+		}
+
+		check.columnStatuses(field+".statuses", column.statuses, ret[:index])
+
+		ret[index] = BoardColumn{
+			key:      key,
+			name:     check.columnName(field+".name", column.name),
+			statuses: slices.Clone(column.statuses),
+		}
+	}
+
+	err := check.err()
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// Columns 보드의 열을 순서대로 반환합니다. 열을 설정하지 않았으면 DefaultBoardColumns입니다.
+func (p *Project) Columns() []BoardColumn {
+	return cloneColumns(p.columns)
+}
+
+// SetColumns 보드의 열을 columns로 바꾸고 수정 정보를 stamp로 바꾼 새 Project를 반환합니다.
+// columns는 NewBoardColumns로 검증한 값이어야 합니다.
+func (p *Project) SetColumns(columns []BoardColumn, stamp Stamp) *Project {
+	ret := p.Clone()
+	ret.columns = cloneColumns(columns)
+	ret.updated = stamp
+
+	return ret
+}
+
+// Column 키가 key인 열을 찾습니다. 대소문자를 구분하지 않습니다.
+func (p *Project) Column(key string) (BoardColumn, bool) {
+	key = strings.ToLower(key)
+
+	return p.findColumn(func(column BoardColumn) bool { return column.key == key })
+}
+
+// ColumnOf 상태가 status인 Task가 놓이는 열을 찾습니다. 어느 열에도 속하지 않는 상태의 Task는 보드에 보이지 않습니다.
+func (p *Project) ColumnOf(status TaskStatus) (BoardColumn, bool) {
+	return p.findColumn(func(column BoardColumn) bool { return slices.Contains(column.statuses, status) })
+}
+
+func (p *Project) findColumn(match func(BoardColumn) bool) (BoardColumn, bool) {
+	columns := p.Columns()
+
+	index := slices.IndexFunc(columns, match)
+	if index < 0 {
+		return BoardColumn{key: "", name: "", statuses: nil}, false
+	}
+
+	return columns[index], true
+}
+
+// MoveOnBoard column 열의 rank 위치로 옮긴 새 Task를 반환합니다. 상태가 열의 상태가 아니면 열의 상태 중
+// 전이 테이블로 갈 수 있는 첫 번째 상태로 바뀌며, 갈 수 있는 상태가 없으면 ErrInvalidTransition을 반환합니다.
+func (t *Task) MoveOnBoard(column BoardColumn, rank string) (*Task, error) {
+	ret := t.Clone()
+	ret.rank = rank
+
+	if slices.Contains(column.statuses, t.status) {
+		return ret, nil
+	}
+
+	var rejected error
+
+	for _, status := range column.statuses {
+		action, err := ActionTo(t.status, status)
+		if err == nil {
+			return ret.Transition(action)
+		}
+
+		if rejected == nil {
+			rejected = err
+		}
+	}
+
+	return nil, rejected
+}
+
+// columnStatuses 열의 상태가 하나 이상이고 올바르며, 같은 열이나 앞선 열의 상태와 겹치지 않는지 검증합니다.
+func (v *validator) columnStatuses(field string, statuses []TaskStatus, previous []BoardColumn) {
+	if len(statuses) == 0 {
+		v.add(field, ViolationRequired, "")
+
+		return
+	}
+
+	for index, status := range statuses {
+		if !status.valid() {
+			v.add(field, ViolationFormat, "")
+
+			return
+		}
+
+		used := slices.ContainsFunc(previous, func(column BoardColumn) bool {
+			return slices.Contains(column.statuses, status)
+		})
+		if used || slices.Contains(statuses[:index], status) {
+			v.add(field, ViolationDuplicate, "")
+
+			return
+		}
+	}
+}
+
+// boardColumnsOrDefault 저장된 열이 없는 프로젝트는 DefaultBoardColumns를 씁니다.
+func boardColumnsOrDefault(columns []BoardColumn) []BoardColumn {
+	if len(columns) == 0 {
+		return DefaultBoardColumns()
+	}
+
+	return cloneColumns(columns)
+}
+
+func cloneColumns(columns []BoardColumn) []BoardColumn {
+	ret := make([]BoardColumn, len(columns))
+	for i, column := range columns {
+		ret[i] = NewBoardColumn(column.key, column.name, column.statuses)
+	}
+
+	return ret
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MaxChecklistItems Task 하나에 둘 수 있는 체크리스트 항목의 최대 개수입니다.
+const MaxChecklistItems = 100
+
+type ChecklistItemID string
+
+// ChecklistItem Task 체크리스트의 항목 하나입니다. 순서는 Task의 체크리스트 안의 위치로 정해집니다.
+type ChecklistItem struct {
+	id   ChecklistItemID
+	text string
+	done bool
+}
+
+// NewChecklistItem 저장소가 저장된 값으로 체크리스트 항목을 복원합니다. 새 항목은 Task.AddChecklistItem으로 추가합니다.
+func NewChecklistItem(id ChecklistItemID, text string, done bool) ChecklistItem {
+	return ChecklistItem{id: id, text: text, done: done}
+}
+
+func (i ChecklistItem) ID() ChecklistItemID {
+	return i.id
+}
+
+func (i ChecklistItem) Text() string {
+	return i.text
+}
+
+func (i ChecklistItem) Done() bool {
+	return i.done
+}
+
+// Checklist 체크리스트 항목을 순서대로 반환합니다.
+func (t *Task) Checklist() []ChecklistItem {
+	return slices.Clone(t.checklist)
+}
+
+// ChecklistProgress 체크리스트의 완료 현황입니다. 항목이 없으면 Total이 0입니다.
+func (t *Task) ChecklistProgress() Progress {
+	progress := Progress{total: len(t.checklist), completed: 0}
+
+	for _, item := range t.checklist {
+		if item.done {
+			progress.completed++
+		}
+	}
+
+	return progress
+}
+
+// WithChecklist 체크리스트를 items로 바꾼 새 Task를 반환합니다. 저장소가 저장된 값을 반영할 때 사용합니다.
+func (t *Task) WithChecklist(items []ChecklistItem) *Task {
+	ret := t.Clone()
+	ret.checklist = slices.Clone(items)
+
+	return ret
+}
+
+// AddChecklistItem 체크리스트 끝에 완료되지 않은 항목을 추가한 새 Task를 반환합니다. 내용의 앞뒤 공백은 제거되며,
+// 내용이 규칙을 위반하거나 항목이 MaxChecklistItems개를 넘으면 *ValidationError를 반환합니다.
+func (t *Task) AddChecklistItem(id ChecklistItemID, text string) (*Task, error) {
+	var check validator
+
+	text = check.checklistText(text)
+
+	if len(t.checklist) >= MaxChecklistItems {
+		check.add("checklist", ViolationMaxItems, strconv.Itoa(MaxChecklistItems))
+	}
+
+	err := check.err()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := t.Clone()
+	ret.checklist = append(ret.checklist, ChecklistItem{id: id, text: text, done: false})
+
+	return ret, nil
+}
+
+// ReorderChecklist 체크리스트를 ids 순서로 재배열한 새 Task를 반환합니다.
+// ids가 현재 항목을 빠짐없이 한 번씩 담고 있지 않으면 item_ids 필드의 *ValidationError를 반환합니다.
+func (t *Task) ReorderChecklist(ids []ChecklistItemID) (*Task, error) {
+	var check validator
+
+	if len(ids) != len(t.checklist) {
+		check.add("item_ids", ViolationFormat, "")
+
+		return nil, check.err()
+	}
+
+	items := make([]ChecklistItem, len(ids))
+
+	for position, id := range ids {
+		index := t.checklistIndex(id)
+		if index < 0 || slices.Contains(ids[:position], id) {
+			check.add("item_ids", ViolationFormat, "")
+
+			return nil, check.err()
+		}
+
+		items[position] = t.checklist[index]
+	}
+
+	ret := t.Clone()
+	ret.checklist = items
+
+	return ret, nil
+}
+
+// SetChecklistItemDone 항목의 완료 여부를 done으로 바꾼 새 Task를 반환합니다. 항목이 없으면 ErrChecklistItemNotFound를 반환합니다.
+func (t *Task) SetChecklistItemDone(id ChecklistItemID, done bool) (*Task, error) {
+	index := t.checklistIndex(id)
+	if index < 0 {
+		return nil, ErrChecklistItemNotFound
+	}
+
+	ret := t.Clone()
+	ret.checklist[index].done = done
+
+	return ret, nil
+}
+
+// RemoveChecklistItem 항목을 뺀 새 Task를 반환합니다. 항목이 없으면 ErrChecklistItemNotFound를 반환합니다.
+func (t *Task) RemoveChecklistItem(id ChecklistItemID) (*Task, error) {
+	index := t.checklistIndex(id)
+	if index < 0 {
+		return nil, ErrChecklistItemNotFound
+	}
+
+	ret := t.Clone()
+	ret.checklist = slices.Delete(ret.checklist, index, index+1)
+
+	return ret, nil
+}
+
+func (t *Task) checklistIndex(id ChecklistItemID) int {
+	return slices.IndexFunc(t.checklist, func(item ChecklistItem) bool { return item.id == id })
+}
+
+// formatChecklist 감사 기록에 남길 체크리스트 값으로, 항목마다 "[x] 내용" 또는 "[ ] 내용"을 한 줄로 씁니다.
+func formatChecklist(items []ChecklistItem) string {
+	lines := make([]string, len(items))
+
+	for index, item := range items {
+		mark := "[ ] "
+		if item.done {
+			mark = "[x] "
+		}
+
+		lines[index] = mark + item.text
+	}
+
+	return strings.Join(lines, "\n")
+}
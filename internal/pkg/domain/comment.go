@@ -0,0 +1,159 @@
+package domain
+
+import "slices"
+
+type CommentID string
+
+// CommentSpec 댓글 작성·수정 입력입니다. 본문은 Markdown이며 앞뒤 공백이 제거됩니다.
+type CommentSpec struct {
+	body string
+}
+
+// NewCommentSpec 본문을 검증하여 CommentSpec을 생성합니다. 규칙을 위반하면 *ValidationError를 반환합니다.
+func NewCommentSpec(body string) (*CommentSpec, error) {
+	var check validator
+
+	body = check.commentBody(body)
+
+	err := check.err()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommentSpec{body: body}, nil
+}
+
+func (s *CommentSpec) Body() string {
+	return s.body
+}
+
+// CommentParams 저장소가 저장된 값으로 Comment를 복원할 때 NewComment에 전달하는 필드입니다.
+type CommentParams struct {
+	ID       CommentID
+	TaskID   TaskID
+	ParentID CommentID
+	Body     string
+	Created  Stamp
+	Updated  Stamp
+}
+
+// Comment Task에 달린 댓글입니다. ParentID가 비어 있지 않으면 같은 Task의 다른 댓글에 단 답글입니다.
+type Comment struct {
+	id       CommentID
+	taskID   TaskID
+	parentID CommentID
+	body     string
+	created  Stamp
+	updated  Stamp
+}
+
+func NewComment(params CommentParams) *Comment {
+	return &Comment{
+		id:       params.ID,
+		taskID:   params.TaskID,
+		parentID: params.ParentID,
+		body:     params.Body,
+		created:  params.Created,
+		updated:  params.Updated,
+	}
+}
+
+func (c *Comment) ID() CommentID {
+	return c.id
+}
+
+func (c *Comment) TaskID() TaskID {
+	return c.taskID
+}
+
+// ParentID 답글이 가리키는 댓글의 ID입니다. 답글이 아니면 빈 문자열입니다.
+func (c *Comment) ParentID() CommentID {
+	return c.parentID
+}
+
+func (c *Comment) Body() string {
+	return c.body
+}
+
+// Author 댓글을 작성한 주체입니다. 작성자만 댓글을 수정하거나 삭제할 수 있습니다.
+func (c *Comment) Author() string {
+	return c.created.By()
+}
+
+// Created 작성 시각과 작성한 주체입니다.
+func (c *Comment) Created() Stamp {
+	return c.created
+}
+
+// Updated 마지막 수정 시각과 수정한 주체입니다. 수정되지 않았으면 Created와 같습니다.
+func (c *Comment) Updated() Stamp {
+	return c.updated
+}
+
+// IsEdited 작성 후 본문이 수정되었는지 확인합니다.
+func (c *Comment) IsEdited() bool {
+	return !c.updated.At().Equal(c.created.At())
+}
+
+func (c *Comment) Clone() *Comment {
+	ret := *c
+
+	return &ret
+}
+
+// SetSpec 본문을 spec으로 바꾼 새 Comment를 반환합니다.
+func (c *Comment) SetSpec(spec *CommentSpec) *Comment {
+	ret := c.Clone()
+	ret.body = spec.body
+
+	return ret
+}
+
+// Touch 마지막 수정 정보를 stamp로 바꾼 새 Comment를 반환합니다.
+func (c *Comment) Touch(stamp Stamp) *Comment {
+	ret := c.Clone()
+	ret.updated = stamp
+
+	return ret
+}
+
+// CommentThread 댓글과 그 아래에 달린 답글입니다.
+type CommentThread struct {
+	comment *Comment
+	replies []*CommentThread
+}
+
+func (t *CommentThread) Comment() *Comment {
+	return t.comment
+}
+
+// Replies 이 댓글에 달린 답글을 작성 순서대로 반환합니다.
+func (t *CommentThread) Replies() []*CommentThread {
+	return slices.Clone(t.replies)
+}
+
+// BuildCommentThreads 댓글을 답글 관계에 따라 묶습니다. 같은 단계의 댓글은 comments의 순서를 따르며,
+// 가리키는 댓글이 comments에 없는 답글은 최상위 댓글로 취급합니다.
+func BuildCommentThreads(comments []*Comment) []*CommentThread {
+	threads := make(map[CommentID]*CommentThread, len(comments))
+	for _, comment := range comments {
+		threads[comment.id] = &CommentThread{comment: comment, replies: nil}
+	}
+
+	var roots []*CommentThread
+
+	for _, comment := range comments {
+		thread := threads[comment.id]
+
+		parent, exists := threads[comment.parentID]
+		if !exists {
+			roots = append(roots, thread)
+
+			continue
+		}
+
+		parent.replies = append(parent.replies, thread)
+	}
+
+	return roots
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+func TestBuildCommentThreads(t *testing.T) {
+	t.Parallel()
+
+	comments := []*domain.Comment{
+		newComment("1", ""),
+		newComment("2", "1"),
+		newComment("3", ""),
+		newComment("4", "2"),
+		newComment("5", "1"),
+		// 가리키는 댓글이 목록에 없는 답글은 최상위 댓글이 됩니다.
+		newComment("6", "missing"),
+	}
+
+	got := formatThreads(domain.BuildCommentThreads(comments))
+
+	want := "1(2(4) 5) 3 6"
+	if got != want {
+		t.Errorf("BuildCommentThreads = %q, want %q", got, want)
+	}
+
+	if threads := domain.BuildCommentThreads(nil); len(threads) != 0 {
+		t.Errorf("BuildCommentThreads(nil) = %v, want no threads", threads)
+	}
+}
+
+// formatThreads 스레드를 "ID(답글 ...)" 형식의 문자열로 나타냅니다.
+func formatThreads(threads []*domain.CommentThread) string {
+	parts := make([]string, len(threads))
+
+	for i, thread := range threads {
+		parts[i] = string(thread.Comment().ID())
+		if len(thread.Replies()) > 0 {
+			parts[i] += "(" + formatThreads(thread.Replies()) + ")"
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func newComment(id, parentID domain.CommentID) *domain.Comment {
+	return domain.NewComment(domain.CommentParams{ID: id, TaskID: "task", ParentID: parentID}) //nolint:exhaustruct
+}
+
+func TestNewCommentSpec(t *testing.T) {
+	t.Parallel()
+
+	spec, err := domain.NewCommentSpec("  **looks good**\n\n- [x] tested\t \n")
+	if err != nil || spec.Body() != "**looks good**\n\n- [x] tested" {
+		t.Errorf("NewCommentSpec = %v, %v, want a trimmed multi-line body", spec, err)
+	}
+
+	tests := []struct {
+		body string
+		want []domain.Violation
+	}{
+		{" \n ", []domain.Violation{violation("body", domain.ViolationRequired, "")}},
+		{
+			strings.Repeat("a", domain.MaxCommentLength+1),
+			[]domain.Violation{violation("body", domain.ViolationMaxLength, "10000")},
+		},
+		{"bell\a", []domain.Violation{violation("body", domain.ViolationControlCharacter, "")}},
+	}
+
+	for _, tc := range tests {
+		_, err := domain.NewCommentSpec(tc.body)
+		assertViolations(t, tc.body, err, tc.want)
+	}
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Dependency Task가 DependsOn이 끝나야 진행할 수 있다는 선후 관계입니다.
+// 즉 Task는 DependsOn에 의해 막혀 있습니다(blocked by).
+type Dependency struct {
+	task      TaskID
+	dependsOn TaskID
+}
+
+// NewDependency task가 dependsOn에 의해 막혀 있다는 의존성을 생성합니다.
+func NewDependency(task, dependsOn TaskID) Dependency {
+	return Dependency{task: task, dependsOn: dependsOn}
+}
+
+// Task 막혀 있는 후행 Task입니다.
+func (d Dependency) Task() TaskID {
+	return d.task
+}
+
+// DependsOn 먼저 끝나야 하는 선행 Task입니다.
+func (d Dependency) DependsOn() TaskID {
+	return d.dependsOn
+}
+
+// OrderByDependencies ids를 선행 Task가 항상 먼저 오도록 정렬합니다.
+// 양 끝이 모두 ids에 포함된 의존성만 고려하며, 순서가 정해지지 않는 Task끼리는 ID 순서입니다.
+// 의존성에 순환이 있으면 ErrDependencyCycle을 반환합니다.
+func OrderByDependencies(ids []TaskID, dependencies []Dependency) ([]TaskID, error) {
+	inDegree, dependents := dependencyGraph(ids, dependencies)
+
+	var ready []TaskID
+
+	for id, degree := range inDegree {
+		if degree == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	ordered := make([]TaskID, 0, len(inDegree))
+
+	for len(ready) > 0 {
+		slices.SortFunc(ready, cmp.Compare)
+
+		id := ready[0]
+		ready = ready[1:]
+
+		ordered = append(ordered, id)
+
+		for _, dependent := range dependents[id] {
+			inDegree[dependent]--
+			if inDegree[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(ordered) != len(inDegree) {
+		return nil, ErrDependencyCycle
+	}
+
+	return ordered, nil
+}
+
+// dependencyGraph ids 안의 의존성만 골라 Task별 선행 Task 수와 후행 Task 목록을 만듭니다.
+func dependencyGraph(ids []TaskID, dependencies []Dependency) (map[TaskID]int, map[TaskID][]TaskID) {
+	inDegree := make(map[TaskID]int, len(ids))
+	for _, id := range ids {
+		inDegree[id] = 0
+	}
+
+	dependents := make(map[TaskID][]TaskID)
+
+	for _, dep := range dependencies {
+		_, hasTask := inDegree[dep.Task()]
+		_, hasDependsOn := inDegree[dep.DependsOn()]
+
+		if hasTask && hasDependsOn {
+			inDegree[dep.Task()]++
+			dependents[dep.DependsOn()] = append(dependents[dep.DependsOn()], dep.Task())
+		}
+	}
+
+	return inDegree, dependents
+}
@@ -0,0 +1,61 @@
+package domain_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+func TestOrderByDependencies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		ids     []domain.TaskID
+		deps    []domain.Dependency
+		want    []domain.TaskID
+		wantErr error
+	}{
+		{"no dependencies", []domain.TaskID{"c", "a", "b"}, nil, []domain.TaskID{"a", "b", "c"}, nil},
+		{
+			"chain",
+			[]domain.TaskID{"a", "b", "c"},
+			[]domain.Dependency{domain.NewDependency("a", "b"), domain.NewDependency("b", "c")},
+			[]domain.TaskID{"c", "b", "a"},
+			nil,
+		},
+		{
+			"diamond breaks ties by id",
+			[]domain.TaskID{"a", "b", "c", "d"},
+			[]domain.Dependency{
+				domain.NewDependency("a", "c"), domain.NewDependency("a", "b"),
+				domain.NewDependency("b", "d"), domain.NewDependency("c", "d"),
+			},
+			[]domain.TaskID{"d", "b", "c", "a"},
+			nil,
+		},
+		{
+			"dependencies outside ids are ignored",
+			[]domain.TaskID{"a", "b"},
+			[]domain.Dependency{domain.NewDependency("a", "z"), domain.NewDependency("z", "b")},
+			[]domain.TaskID{"a", "b"},
+			nil,
+		},
+		{
+			"cycle",
+			[]domain.TaskID{"a", "b", "c"},
+			[]domain.Dependency{domain.NewDependency("a", "b"), domain.NewDependency("b", "a")},
+			nil,
+			domain.ErrDependencyCycle,
+		},
+	}
+
+	for _, tc := range tests {
+		got, err := domain.OrderByDependencies(tc.ids, tc.deps)
+		if !slices.Equal(got, tc.want) || !errors.Is(err, tc.wantErr) {
+			t.Errorf("%s: OrderByDependencies = %v, %v, want %v, %v", tc.name, got, err, tc.want, tc.wantErr)
+		}
+	}
+}
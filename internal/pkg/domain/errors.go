@@ -0,0 +1,13 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidTaskStatus     = errors.New("invalid task status")
+	ErrInvalidTaskAction     = errors.New("invalid task action")
+	ErrInvalidTaskPriority   = errors.New("invalid task priority")
+	ErrInvalidTransition     = errors.New("invalid task status transition")
+	ErrValidation            = errors.New("task validation failed")
+	ErrDependencyCycle       = errors.New("task dependencies form a cycle")
+	ErrChecklistItemNotFound = errors.New("checklist item not found")
+)
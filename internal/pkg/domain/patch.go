@@ -0,0 +1,88 @@
+package domain
+
+import "time"
+
+// TaskPatch Task의 부분 수정 내용입니다. 지정하지 않은 필드는 변경되지 않습니다.
+// 상태는 Transition을 통해서만 변경되므로 패치 대상이 아닙니다.
+type TaskPatch struct {
+	title       *string
+	description *string
+	priority    *TaskPriority
+
+	// dueDate nil 값 자체가 마감일 삭제를 뜻하므로 지정 여부를 따로 기록합니다.
+	dueDate    *time.Time
+	dueDateSet bool
+}
+
+func NewTaskPatch() *TaskPatch {
+	return &TaskPatch{
+		title:       nil,
+		description: nil,
+		priority:    nil,
+		dueDate:     nil,
+		dueDateSet:  false,
+	}
+}
+
+func (p *TaskPatch) WithTitle(title string) *TaskPatch {
+	p.title = &title
+
+	return p
+}
+
+func (p *TaskPatch) WithDescription(description string) *TaskPatch {
+	p.description = &description
+
+	return p
+}
+
+func (p *TaskPatch) WithPriority(priority TaskPriority) *TaskPatch {
+	p.priority = &priority
+
+	return p
+}
+
+// WithDueDate 마감일을 변경합니다. dueDate가 nil이면 마감일을 삭제합니다.
+func (p *TaskPatch) WithDueDate(dueDate *time.Time) *TaskPatch {
+	p.dueDate = utcTime(dueDate)
+	p.dueDateSet = true
+
+	return p
+}
+
+// Title 변경할 제목과 지정 여부를 반환합니다.
+func (p *TaskPatch) Title() (string, bool) {
+	if p.title == nil {
+		return "", false
+	}
+
+	return *p.title, true
+}
+
+// Description 변경할 설명과 지정 여부를 반환합니다.
+func (p *TaskPatch) Description() (string, bool) {
+	if p.description == nil {
+		return "", false
+	}
+
+	return *p.description, true
+}
+
+// Priority 변경할 우선순위와 지정 여부를 반환합니다.
+func (p *TaskPatch) Priority() (TaskPriority, bool) {
+	if p.priority == nil {
+		return "", false
+	}
+
+	return *p.priority, true
+}
+
+// DueDate 변경할 마감일과 지정 여부를 반환합니다. 마감일 삭제는 (nil, true)입니다.
+func (p *TaskPatch) DueDate() (*time.Time, bool) {
+	return copyTime(p.dueDate), p.dueDateSet
+}
+
+// IsEmpty 변경할 필드가 하나도 없는지 확인합니다.
+func (p *TaskPatch) IsEmpty() bool {
+	return p.title == nil && p.description == nil && p.priority == nil && !p.dueDateSet
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "fmt"
+
+type TaskPriority string
+
+const (
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityMedium TaskPriority = "medium"
+	TaskPriorityHigh   TaskPriority = "high"
+	TaskPriorityUrgent TaskPriority = "urgent"
+)
+
+// ParseTaskPriority 문자열을 TaskPriority로 변환합니다. 빈 문자열은 TaskPriorityMedium으로 취급합니다.
+func ParseTaskPriority(value string) (TaskPriority, error) {
+	if value == "" {
+		return TaskPriorityMedium, nil
+	}
+
+	priority := TaskPriority(value)
+	if priority.Rank() == 0 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTaskPriority, value)
+	}
+
+	return priority, nil
+}
+
+// TaskPriorityFromRank Rank의 역변환입니다. 알 수 없는 순위는 TaskPriorityMedium으로 취급합니다.
+func TaskPriorityFromRank(rank int) TaskPriority {
+	for _, priority := range []TaskPriority{TaskPriorityLow, TaskPriorityMedium, TaskPriorityHigh, TaskPriorityUrgent} {
+		if priority.Rank() == rank {
+			return priority
+		}
+	}
+
+	return TaskPriorityMedium
+}
+
+// Rank 우선순위를 정렬할 수 있는 순위로 변환합니다. 긴급할수록 크며, 알 수 없는 값은 0입니다.
+// 0은 저장소에서 "값 없음"과 구분되지 않으므로 유효한 순위는 1부터 시작합니다.
+func (p TaskPriority) Rank() int {
+	switch p {
+	case TaskPriorityLow:
+		return 1
+	case TaskPriorityMedium:
+		return 2 //nolint:mnd
+	case TaskPriorityHigh:
+		return 3 //nolint:mnd
+	case TaskPriorityUrgent:
+		return 4 //nolint:mnd
+	default:
+		return 0
+	}
+}
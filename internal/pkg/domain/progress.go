@@ -0,0 +1,47 @@
+package domain
+
+const percent = 100
+
+// Progress 하위 Task 전체의 완료 현황입니다. 취소된 Task는 집계에서 제외합니다.
+type Progress struct {
+	total     int
+	completed int
+}
+
+// NewProgress 하위 Task 목록으로 완료 현황을 집계합니다.
+func NewProgress(subtasks []*Task) Progress {
+	var progress Progress
+
+	for _, task := range subtasks {
+		switch task.status {
+		case TaskStatusCancelled:
+			continue
+		case TaskStatusDone:
+			progress.completed++
+		case TaskStatusOpen, TaskStatusInProgress, TaskStatusBlocked:
+		}
+
+		progress.total++
+	}
+
+	return progress
+}
+
+// Total 집계한 하위 Task 수입니다.
+func (p Progress) Total() int {
+	return p.total
+}
+
+// Completed 완료된 하위 Task 수입니다.
+func (p Progress) Completed() int {
+	return p.completed
+}
+
+// Percent 완료 비율을 0-100 사이의 정수로 반환합니다. 집계할 하위 Task가 없으면 0입니다.
+func (p Progress) Percent() int {
+	if p.total == 0 {
+		return 0
+	}
+
+	return p.completed * percent / p.total
+}
@@ -0,0 +1,173 @@
+package domain
+
+import "strconv"
+
+type ProjectID string
+
+// ProjectKey Task 키의 접두사로 쓰이는 프로젝트의 짧은 대문자 식별자입니다. 예: OPS
+type ProjectKey string
+
+// ParseProjectKey 키를 대문자로 정규화하여 검증합니다. 영문 대문자로 시작하는 2-10자의 영문 대문자와 숫자만 허용하며,
+// 규칙을 위반하면 *ValidationError를 반환합니다.
+func ParseProjectKey(key string) (ProjectKey, error) {
+	var check validator
+
+	key = check.projectKey(key)
+
+	err := check.err()
+	if err != nil {
+		return "", err
+	}
+
+	return ProjectKey(key), nil
+}
+
+// ProjectSpecParams NewProjectSpec에 전달하는 사용자 입력입니다.
+// DefaultPriority가 비어 있으면 TaskPriorityMedium입니다.
+type ProjectSpecParams struct {
+	Name            string
+	Description     string
+	DefaultPriority TaskPriority
+}
+
+// ProjectSpec 프로젝트 생성·수정 입력입니다. 키는 Task 키에 쓰이므로 생성할 때만 정합니다.
+type ProjectSpec struct {
+	name            string
+	description     string
+	defaultPriority TaskPriority
+}
+
+// NewProjectSpec 입력을 검증하여 ProjectSpec을 생성합니다. 이름의 앞뒤 공백은 제거되며,
+// 규칙을 위반하면 필드별 정보를 담은 *ValidationError를 반환합니다.
+func NewProjectSpec(params ProjectSpecParams) (*ProjectSpec, error) {
+	var check validator
+
+	name := check.projectName(params.Name)
+	description := check.description(params.Description)
+
+	err := check.err()
+	if err != nil {
+		return nil, err
+	}
+
+	priority := params.DefaultPriority
+	if priority == "" {
+		priority = TaskPriorityMedium
+	}
+
+	return &ProjectSpec{name: name, description: description, defaultPriority: priority}, nil
+}
+
+func (s *ProjectSpec) Name() string {
+	return s.name
+}
+
+func (s *ProjectSpec) Description() string {
+	return s.description
+}
+
+// DefaultPriority 우선순위를 지정하지 않고 프로젝트에 만든 Task의 우선순위입니다.
+func (s *ProjectSpec) DefaultPriority() TaskPriority {
+	return s.defaultPriority
+}
+
+// ProjectParams 저장소가 저장된 값으로 Project를 복원할 때 NewProject에 전달하는 필드입니다.
+type ProjectParams struct {
+	ID              ProjectID
+	Key             ProjectKey
+	Name            string
+	Description     string
+	DefaultPriority TaskPriority
+	// Columns 보드의 열로, 비어 있으면 DefaultBoardColumns를 씁니다.
+	Columns []BoardColumn
+	// Version 낙관적 동시성 제어에 쓰는 버전으로, 생성하면 1이고 수정할 때마다 1씩 증가합니다.
+	Version int
+	Created Stamp
+	Updated Stamp
+}
+
+// Project Task를 묶는 프로젝트입니다. 키는 저장소 안에서 유일하며 바뀌지 않습니다.
+type Project struct {
+	id              ProjectID
+	key             ProjectKey
+	name            string
+	description     string
+	defaultPriority TaskPriority
+	columns         []BoardColumn
+	version         int
+	created         Stamp
+	updated         Stamp
+}
+
+func NewProject(params ProjectParams) *Project {
+	return &Project{
+		id:              params.ID,
+		key:             params.Key,
+		name:            params.Name,
+		description:     params.Description,
+		defaultPriority: params.DefaultPriority,
+		columns:         boardColumnsOrDefault(params.Columns),
+		version:         params.Version,
+		created:         params.Created,
+		updated:         params.Updated,
+	}
+}
+
+func (p *Project) ID() ProjectID {
+	return p.id
+}
+
+func (p *Project) Key() ProjectKey {
+	return p.key
+}
+
+func (p *Project) Name() string {
+	return p.name
+}
+
+func (p *Project) Description() string {
+	return p.description
+}
+
+// DefaultPriority 우선순위를 지정하지 않고 프로젝트에 만든 Task의 우선순위입니다.
+func (p *Project) DefaultPriority() TaskPriority {
+	return p.defaultPriority
+}
+
+// Version 낙관적 동시성 제어에 쓰는 버전입니다.
+func (p *Project) Version() int {
+	return p.version
+}
+
+// Created 생성 시각과 생성한 주체입니다.
+func (p *Project) Created() Stamp {
+	return p.created
+}
+
+// Updated 마지막 수정 시각과 수정한 주체입니다. 생성 직후에는 Created와 같습니다.
+func (p *Project) Updated() Stamp {
+	return p.updated
+}
+
+// TaskKey 프로젝트에서 number번째로 만든 Task의 키입니다. 예: OPS-42
+func (p *Project) TaskKey(number int) string {
+	return string(p.key) + "-" + strconv.Itoa(number)
+}
+
+func (p *Project) Clone() *Project {
+	ret := *p
+	ret.columns = cloneColumns(p.columns)
+
+	return &ret
+}
+
+// SetSpec 키를 제외한 내용을 spec으로 바꾸고 수정 정보를 stamp로 바꾼 새 Project를 반환합니다.
+func (p *Project) SetSpec(spec *ProjectSpec, stamp Stamp) *Project {
+	ret := p.Clone()
+	ret.name = spec.name
+	ret.description = spec.description
+	ret.defaultPriority = spec.defaultPriority
+	ret.updated = stamp
+
+	return ret
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"cmp"
+	"strings"
+)
+
+// rankDigits 순서 문자열에 쓰는 36진수 숫자로, 바이트 순서가 숫자 순서와 같습니다.
+// ULID의 Crockford Base32 문자도 모두 포함하므로 ID를 그대로 순서로 쓸 수 있습니다.
+const rankDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// RankBetween lower와 upper 사이에 오는 순서 문자열을 반환합니다. lower가 비어 있으면 맨 앞을,
+// upper가 비어 있으면 맨 뒤를 뜻합니다. 양쪽 사이에 항상 새 값을 만들 수 있으므로
+// Task 하나를 옮길 때 다른 Task의 순서를 바꿀 필요가 없습니다.
+// 반환값은 '0'으로 끝나지 않으며, upper가 lower보다 크지 않으면 lower 바로 뒤의 값을 반환합니다.
+func RankBetween(lower, upper string) string {
+	if upper != "" && upper <= lower {
+		upper = ""
+	}
+
+	return rankMidpoint(lower, upper)
+}
+
+// CompareRank 보드에 놓일 순서대로 a와 b를 비교합니다. 순서가 같으면 ID로 비교합니다.
+func CompareRank(a, b *Task) int {
+	return cmp.Or(strings.Compare(a.rank, b.rank), strings.Compare(string(a.id), string(b.id)))
+}
+
+// rankMidpoint lower < upper인 두 순서 문자열의 중간값을 자릿수별로 구합니다. upper가 비어 있으면 무한대로 취급합니다.
+func rankMidpoint(lower, upper string) string {
+	if upper != "" {
+		// 공통 접두사는 그대로 두고 나머지 자리에서 중간값을 구합니다.
+		prefix := 0
+		for prefix < len(upper) && rankDigitAt(lower, prefix) == upper[prefix] {
+			prefix++
+		}
+
+		if prefix > 0 {
+			return upper[:prefix] + rankMidpoint(rankTail(lower, prefix), upper[prefix:])
+		}
+	}
+
+	low := rankIndex(rankDigitAt(lower, 0))
+
+	high := len(rankDigits)
+	if upper != "" {
+		high = rankIndex(upper[0])
+	}
+
+	if high-low > 1 {
+		return string(rankDigits[(low+high)/2])
+	}
+
+	// 첫 자리가 이웃한 숫자이면 upper의 첫 자리만으로 충분하거나, lower의 첫 자리 뒤에서 중간값을 찾습니다.
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+
+	return string(rankDigits[low]) + rankMidpoint(rankTail(lower, 1), "")
+}
+
+// rankDigitAt rank의 index번째 자리를 반환합니다. 자리가 없으면 '0'으로 채웁니다.
+func rankDigitAt(rank string, index int) byte {
+	if index < len(rank) {
+		return rank[index]
+	}
+
+	return rankDigits[0]
+}
+
+func rankTail(rank string, from int) string {
+	if from < len(rank) {
+		return rank[from:]
+	}
+
+	return ""
+}
+
+// rankIndex 순서 문자의 값을 반환합니다. 알 수 없는 문자는 0으로 취급합니다.
+func rankIndex(digit byte) int {
+	return max(strings.IndexByte(rankDigits, digit), 0)
+}
+
+// initialRank 순서가 정해지지 않은 Task의 순서로, 끝의 '0'을 제거한 ID를 씁니다.
+// ULID는 만든 순서대로 커지므로 보드에서 옮기지 않은 Task는 만든 순서대로 놓입니다.
+func initialRank(id TaskID, rank string) string {
+	if rank != "" {
+		return rank
+	}
+
+	return strings.TrimRight(string(id), rankDigits[:1])
+}
@@ -0,0 +1,109 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+func TestRankBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		lower, upper string
+		want         string
+	}{
+		{"", "", "I"},
+		{"", "1", "0I"},
+		{"A", "", "N"},
+		{"Z", "", "ZI"},
+		{"A", "C", "B"},
+		{"A", "B", "AI"},
+		{"A", "A1", "A0I"},
+		{"A1", "B", "AI"},
+		{"AZ", "B", "AZI"},
+		{"B", "B", "N"},
+		{"C", "A", "O"},
+	}
+
+	for _, tc := range tests {
+		got := domain.RankBetween(tc.lower, tc.upper)
+		if got != tc.want {
+			t.Errorf("RankBetween(%q, %q) = %q, want %q", tc.lower, tc.upper, got, tc.want)
+		}
+
+		assertRankBetween(t, tc.lower, tc.upper, got)
+	}
+}
+
+// TestRankBetweenRepeated 같은 자리에 계속 끼워 넣어도 순서가 유지되는지 확인합니다.
+func TestRankBetweenRepeated(t *testing.T) {
+	t.Parallel()
+
+	const inserts = 100
+
+	lower, upper := "A", "B"
+	for range inserts {
+		rank := domain.RankBetween(lower, upper)
+		assertRankBetween(t, lower, upper, rank)
+
+		upper = rank
+	}
+
+	lower, upper = "A", "B"
+	for range inserts {
+		rank := domain.RankBetween(lower, upper)
+		assertRankBetween(t, lower, upper, rank)
+
+		lower = rank
+	}
+}
+
+func assertRankBetween(t *testing.T, lower, upper, got string) {
+	t.Helper()
+
+	if upper != "" && upper <= lower {
+		upper = ""
+	}
+
+	if got <= lower || (upper != "" && got >= upper) || strings.HasSuffix(got, "0") {
+		t.Errorf("RankBetween(%q, %q) = %q, want a rank between them not ending in 0", lower, upper, got)
+	}
+}
+
+func TestCompareRank(t *testing.T) {
+	t.Parallel()
+
+	first := rankedTask("01B", "M")
+	second := rankedTask("01A", "N")
+	tied := rankedTask("01C", "M")
+	// 순서가 정해지지 않은 Task는 끝의 '0'을 뗀 ID를 순서로 씁니다.
+	unranked := rankedTask("01M0", "")
+
+	if got := unranked.Rank(); got != "01M" {
+		t.Errorf("initial rank = %q, want %q", got, "01M")
+	}
+
+	tests := []struct {
+		a, b *domain.Task
+		want int
+	}{
+		{first, second, -1},
+		{second, first, 1},
+		{first, tied, -1},
+		{tied, first, 1},
+		{first, first, 0},
+		{unranked, first, -1},
+	}
+
+	for _, tc := range tests {
+		if got := domain.CompareRank(tc.a, tc.b); got != tc.want {
+			t.Errorf("CompareRank(%s, %s) = %d, want %d", tc.a.ID(), tc.b.ID(), got, tc.want)
+		}
+	}
+}
+
+func rankedTask(id domain.TaskID, rank string) *domain.Task {
+	return domain.NewTask(domain.TaskParams{ID: id, Rank: rank}) //nolint:exhaustruct
+}
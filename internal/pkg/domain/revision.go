@@ -0,0 +1,46 @@
+package domain
+
+import "slices"
+
+// RevisionDiff 같은 Task의 두 리비전 사이에서 값이 다른 필드입니다. 리비전 번호는 그 리비전의 Task 버전입니다.
+type RevisionDiff struct {
+	taskID  TaskID
+	from    int
+	to      int
+	changes []FieldChange
+}
+
+// NewRevisionDiff from 리비전에서 to 리비전으로의 변경을 DiffTasks와 같은 형식으로 만듭니다.
+func NewRevisionDiff(from, to *Task) RevisionDiff {
+	return RevisionDiff{
+		taskID:  to.id,
+		from:    from.version,
+		to:      to.version,
+		changes: DiffTasks(from, to),
+	}
+}
+
+func (d RevisionDiff) TaskID() TaskID {
+	return d.taskID
+}
+
+// From 비교의 기준이 되는 리비전 번호입니다.
+func (d RevisionDiff) From() int {
+	return d.from
+}
+
+// To 비교할 리비전 번호입니다.
+func (d RevisionDiff) To() int {
+	return d.to
+}
+
+// Changes from 리비전에서 to 리비전으로 값이 바뀐 필드를 고정된 순서로 반환합니다.
+func (d RevisionDiff) Changes() []FieldChange {
+	return slices.Clone(d.changes)
+}
+
+// Revert revision의 내용으로 되돌린 새 Task를 반환합니다. SetSpec과 같이 제목, 설명, 우선순위, 마감일만 되돌리며
+// 상태와 상위 Task, 태그, 체크리스트는 그대로 둡니다. 버전과 수정 정보는 저장할 때 새로 기록됩니다.
+func (t *Task) Revert(revision *Task) *Task {
+	return t.SetSpec(revision.Spec())
+}
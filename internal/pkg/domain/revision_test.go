@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+func TestDiffTasks(t *testing.T) {
+	t.Parallel()
+
+	created := domain.NewStamp(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), "alice")
+	due := time.Date(2025, 2, 1, 0, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+
+	before := domain.NewTask(domain.TaskParams{ //nolint:exhaustruct
+		ID:       "task",
+		Title:    "Write report",
+		Status:   domain.TaskStatusOpen,
+		Priority: domain.TaskPriorityMedium,
+		Rank:     "M",
+		Version:  1,
+		Created:  created,
+		Updated:  created,
+	})
+
+	after := domain.NewTask(domain.TaskParams{ //nolint:exhaustruct
+		ID:        "task",
+		Title:     "Write report",
+		Status:    domain.TaskStatusInProgress,
+		Priority:  domain.TaskPriorityMedium,
+		DueDate:   &due,
+		Rank:      "M",
+		Tags:      []*domain.Tag{domain.NewTag("t1", "work", ""), domain.NewTag("t2", "urgent", "")},
+		Checklist: []domain.ChecklistItem{domain.NewChecklistItem("c1", "draft", true), domain.NewChecklistItem("c2", "review", false)},
+		Version:   2,
+		Created:   created,
+		Updated:   domain.NewStamp(created.At().Add(time.Hour), "bob"),
+	})
+
+	// 필드는 정해진 순서로 비교하며 버전과 수정 정보는 비교하지 않습니다. 태그는 이름 순서입니다.
+	want := []domain.FieldChange{
+		domain.NewFieldChange("status", "open", "in_progress"),
+		domain.NewFieldChange("due_date", "", "2025-01-31T15:00:00Z"),
+		domain.NewFieldChange("tags", "", "urgent,work"),
+		domain.NewFieldChange("checklist", "", "[x] draft\n[ ] review"),
+	}
+
+	if got := domain.DiffTasks(before, after); !slices.Equal(got, want) {
+		t.Errorf("DiffTasks = %v, want %v", got, want)
+	}
+
+	if got := domain.DiffTasks(after, after.Clone()); len(got) != 0 {
+		t.Errorf("DiffTasks of equal tasks = %v, want no changes", got)
+	}
+}
+
+// TestDiffTasksCreated 생성은 before가 nil이며, 비어 있지 않은 필드만 변경으로 기록합니다.
+func TestDiffTasksCreated(t *testing.T) {
+	t.Parallel()
+
+	task := domain.NewTask(domain.TaskParams{ //nolint:exhaustruct
+		ID:        "01TASK",
+		Title:     "Write report",
+		Status:    domain.TaskStatusOpen,
+		Priority:  domain.TaskPriorityHigh,
+		ProjectID: "project",
+		Key:       "OPS-1",
+		Version:   1,
+	})
+
+	want := []domain.FieldChange{
+		domain.NewFieldChange("title", "", "Write report"),
+		domain.NewFieldChange("status", "", "open"),
+		domain.NewFieldChange("priority", "", "high"),
+		domain.NewFieldChange("project_id", "", "project"),
+		domain.NewFieldChange("key", "", "OPS-1"),
+		domain.NewFieldChange("rank", "", "01TASK"),
+	}
+
+	if got := domain.DiffTasks(nil, task); !slices.Equal(got, want) {
+		t.Errorf("DiffTasks(nil, task) = %v, want %v", got, want)
+	}
+}
+
+func TestNewRevisionDiff(t *testing.T) {
+	t.Parallel()
+
+	from := domain.NewTask(domain.TaskParams{ //nolint:exhaustruct
+		ID: "task", Title: "Draft", Rank: "M", Version: 2,
+	})
+	to := domain.NewTask(domain.TaskParams{ //nolint:exhaustruct
+		ID: "task", Title: "Final", Description: "done", Rank: "M", Version: 5,
+	})
+
+	got := domain.NewRevisionDiff(from, to)
+
+	want := []domain.FieldChange{
+		domain.NewFieldChange("title", "Draft", "Final"),
+		domain.NewFieldChange("description", "", "done"),
+	}
+
+	if got.TaskID() != "task" || got.From() != 2 || got.To() != 5 || !slices.Equal(got.Changes(), want) {
+		t.Errorf("NewRevisionDiff = %+v, want task 2 -> 5 with %v", got, want)
+	}
+}
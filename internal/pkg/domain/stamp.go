@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Stamp 변경이 일어난 시각과 변경한 주체(actor)입니다.
+type Stamp struct {
+	at time.Time
+	by string
+}
+
+// NewStamp at 시각에 by가 변경했다는 Stamp를 생성합니다.
+func NewStamp(at time.Time, by string) Stamp {
+	return Stamp{at: at, by: by}
+}
+
+func (s Stamp) At() time.Time {
+	return s.at
+}
+
+func (s Stamp) By() string {
+	return s.by
+}
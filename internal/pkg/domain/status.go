@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+type TaskStatus string
+
+const (
+	TaskStatusOpen       TaskStatus = "open"
+	TaskStatusInProgress TaskStatus = "in_progress"
+	TaskStatusBlocked    TaskStatus = "blocked"
+	TaskStatusDone       TaskStatus = "done"
+	TaskStatusCancelled  TaskStatus = "cancelled"
+)
+
+// ParseTaskStatus 문자열을 TaskStatus로 변환합니다. 빈 문자열은 TaskStatusOpen으로 취급합니다.
+func ParseTaskStatus(value string) (TaskStatus, error) {
+	if value == "" {
+		return TaskStatusOpen, nil
+	}
+
+	status := TaskStatus(value)
+	if !status.valid() {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTaskStatus, value)
+	}
+
+	return status, nil
+}
+
+// IsClosed 완료되거나 취소되어 더 이상 진행하지 않는 상태인지 확인합니다.
+func (s TaskStatus) IsClosed() bool {
+	return s == TaskStatusDone || s == TaskStatusCancelled
+}
+
+func (s TaskStatus) valid() bool {
+	switch s {
+	case TaskStatusOpen, TaskStatusInProgress, TaskStatusBlocked, TaskStatusDone, TaskStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+type TaskAction string
+
+const (
+	TaskActionStart    TaskAction = "start"
+	TaskActionBlock    TaskAction = "block"
+	TaskActionUnblock  TaskAction = "unblock"
+	TaskActionComplete TaskAction = "complete"
+	TaskActionCancel   TaskAction = "cancel"
+	TaskActionReopen   TaskAction = "reopen"
+)
+
+// ParseTaskAction 문자열을 TaskAction으로 변환합니다.
+func ParseTaskAction(value string) (TaskAction, error) {
+	action := TaskAction(value)
+
+	_, _, ok := action.transition()
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTaskAction, value)
+	}
+
+	return action, nil
+}
+
+// TransitionError 현재 상태에서 허용되지 않는 액션을 적용했을 때의 에러로, ErrInvalidTransition으로 분류됩니다.
+type TransitionError struct {
+	Action TaskAction
+	From   TaskStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s: cannot %s a task in %s status", ErrInvalidTransition, e.Action, e.From)
+}
+
+func (e *TransitionError) Unwrap() error {
+	return ErrInvalidTransition
+}
+
+// Next 현재 상태에서 액션을 적용했을 때의 다음 상태를 반환합니다.
+func (a TaskAction) Next(from TaskStatus) (TaskStatus, error) {
+	sources, target, ok := a.transition()
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTaskAction, a)
+	}
+
+	if !slices.Contains(sources, from) {
+		return "", &TransitionError{Action: a, From: from}
+	}
+
+	return target, nil
+}
+
+// ActionTo from 상태를 to 상태로 바꾸는 액션을 전이 테이블에서 찾습니다. 그런 액션이 없으면
+// to 상태로 가는 액션으로 만든 *TransitionError를 반환합니다.
+func ActionTo(from, to TaskStatus) (TaskAction, error) {
+	var rejected TaskAction
+
+	actions := []TaskAction{
+		TaskActionStart, TaskActionBlock, TaskActionUnblock, TaskActionComplete, TaskActionCancel, TaskActionReopen,
+	}
+
+	for _, action := range actions {
+		sources, target, _ := action.transition()
+		if target != to {
+			continue
+		}
+
+		if slices.Contains(sources, from) {
+			return action, nil
+		}
+
+		if rejected == "" {
+			rejected = action
+		}
+	}
+
+	return "", &TransitionError{Action: rejected, From: from}
+}
+
+// transition 액션별 허용되는 출발 상태와 도착 상태를 정의하는 전이 테이블입니다.
+func (a TaskAction) transition() ([]TaskStatus, TaskStatus, bool) {
+	switch a {
+	case TaskActionStart:
+		return []TaskStatus{TaskStatusOpen}, TaskStatusInProgress, true
+	case TaskActionBlock:
+		return []TaskStatus{TaskStatusOpen, TaskStatusInProgress}, TaskStatusBlocked, true
+	case TaskActionUnblock:
+		return []TaskStatus{TaskStatusBlocked}, TaskStatusInProgress, true
+	case TaskActionComplete:
+		return []TaskStatus{TaskStatusOpen, TaskStatusInProgress}, TaskStatusDone, true
+	case TaskActionCancel:
+		return []TaskStatus{TaskStatusOpen, TaskStatusInProgress, TaskStatusBlocked}, TaskStatusCancelled, true
+	case TaskActionReopen:
+		return []TaskStatus{TaskStatusDone, TaskStatusCancelled}, TaskStatusOpen, true
+	default:
+		return nil, "", false
+	}
+}
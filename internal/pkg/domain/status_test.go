@@ -0,0 +1,152 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+func TestParseTaskStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		want    domain.TaskStatus
+		wantErr error
+	}{
+		{"", domain.TaskStatusOpen, nil},
+		{"open", domain.TaskStatusOpen, nil},
+		{"in_progress", domain.TaskStatusInProgress, nil},
+		{"blocked", domain.TaskStatusBlocked, nil},
+		{"done", domain.TaskStatusDone, nil},
+		{"cancelled", domain.TaskStatusCancelled, nil},
+		{"Done", "", domain.ErrInvalidTaskStatus},
+		{"closed", "", domain.ErrInvalidTaskStatus},
+	}
+
+	for _, tc := range tests {
+		got, err := domain.ParseTaskStatus(tc.value)
+		if got != tc.want || !errors.Is(err, tc.wantErr) {
+			t.Errorf("ParseTaskStatus(%q) = %q, %v, want %q, %v", tc.value, got, err, tc.want, tc.wantErr)
+		}
+	}
+}
+
+func TestParseTaskAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		wantErr error
+	}{
+		{"start", nil},
+		{"block", nil},
+		{"unblock", nil},
+		{"complete", nil},
+		{"cancel", nil},
+		{"reopen", nil},
+		{"", domain.ErrInvalidTaskAction},
+		{"finish", domain.ErrInvalidTaskAction},
+	}
+
+	for _, tc := range tests {
+		got, err := domain.ParseTaskAction(tc.value)
+		if !errors.Is(err, tc.wantErr) || (err == nil && string(got) != tc.value) {
+			t.Errorf("ParseTaskAction(%q) = %q, %v, want error %v", tc.value, got, err, tc.wantErr)
+		}
+	}
+}
+
+// TestTaskActionNext 전이 테이블의 모든 액션과 출발 상태 조합을 확인합니다. 빈 want는 허용되지 않는 전이입니다.
+func TestTaskActionNext(t *testing.T) {
+	t.Parallel()
+
+	statuses := []domain.TaskStatus{
+		domain.TaskStatusOpen,
+		domain.TaskStatusInProgress,
+		domain.TaskStatusBlocked,
+		domain.TaskStatusDone,
+		domain.TaskStatusCancelled,
+	}
+
+	tests := []struct {
+		action domain.TaskAction
+		// want statuses와 같은 순서의 출발 상태별 도착 상태입니다.
+		want []domain.TaskStatus
+	}{
+		{domain.TaskActionStart, []domain.TaskStatus{"in_progress", "", "", "", ""}},
+		{domain.TaskActionBlock, []domain.TaskStatus{"blocked", "blocked", "", "", ""}},
+		{domain.TaskActionUnblock, []domain.TaskStatus{"", "", "in_progress", "", ""}},
+		{domain.TaskActionComplete, []domain.TaskStatus{"done", "done", "", "", ""}},
+		{domain.TaskActionCancel, []domain.TaskStatus{"cancelled", "cancelled", "cancelled", "", ""}},
+		{domain.TaskActionReopen, []domain.TaskStatus{"", "", "", "open", "open"}},
+	}
+
+	for _, tc := range tests {
+		for index, from := range statuses {
+			assertNext(t, tc.action, from, tc.want[index])
+		}
+	}
+
+	_, err := domain.TaskAction("finish").Next(domain.TaskStatusOpen)
+	if !errors.Is(err, domain.ErrInvalidTaskAction) {
+		t.Errorf("unknown action error = %v, want %v", err, domain.ErrInvalidTaskAction)
+	}
+}
+
+func assertNext(t *testing.T, action domain.TaskAction, from, want domain.TaskStatus) {
+	t.Helper()
+
+	got, err := action.Next(from)
+	if want != "" {
+		if got != want || err != nil {
+			t.Errorf("%s from %s = %q, %v, want %q", action, from, got, err, want)
+		}
+
+		return
+	}
+
+	var transitionErr *domain.TransitionError
+	if !errors.As(err, &transitionErr) || !errors.Is(err, domain.ErrInvalidTransition) ||
+		transitionErr.Action != action || transitionErr.From != from {
+		t.Errorf("%s from %s = %q, %v, want a transition error", action, from, got, err)
+	}
+}
+
+func TestActionTo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from, to domain.TaskStatus
+		want     domain.TaskAction
+		// rejected 전이가 없을 때 TransitionError에 담기는 액션입니다.
+		rejected domain.TaskAction
+	}{
+		{"open", "in_progress", domain.TaskActionStart, ""},
+		{"blocked", "in_progress", domain.TaskActionUnblock, ""},
+		{"in_progress", "blocked", domain.TaskActionBlock, ""},
+		{"in_progress", "done", domain.TaskActionComplete, ""},
+		{"blocked", "cancelled", domain.TaskActionCancel, ""},
+		{"done", "open", domain.TaskActionReopen, ""},
+		{"done", "in_progress", "", domain.TaskActionStart},
+		{"blocked", "done", "", domain.TaskActionComplete},
+		{"open", "open", "", domain.TaskActionReopen},
+	}
+
+	for _, tc := range tests {
+		got, err := domain.ActionTo(tc.from, tc.to)
+		if tc.rejected == "" {
+			if got != tc.want || err != nil {
+				t.Errorf("ActionTo(%s, %s) = %q, %v, want %q", tc.from, tc.to, got, err, tc.want)
+			}
+
+			continue
+		}
+
+		var transitionErr *domain.TransitionError
+		if !errors.As(err, &transitionErr) || transitionErr.Action != tc.rejected || transitionErr.From != tc.from {
+			t.Errorf("ActionTo(%s, %s) = %q, %v, want a transition error for %s", tc.from, tc.to, got, err, tc.rejected)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+)
+
+type TagID string
+
+// TagSpec 태그 생성·수정 입력입니다. 이름은 소문자로 정규화됩니다.
+type TagSpec struct {
+	name  string
+	color string
+}
+
+// NewTagSpec 이름과 색상을 검증하여 TagSpec을 생성합니다. 색상은 비어 있거나 "#rrggbb" 형식이어야 합니다.
+func NewTagSpec(name, color string) (*TagSpec, error) {
+	var check validator
+
+	name = check.tagName(name)
+	color = check.color(color)
+
+	err := check.err()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TagSpec{name: name, color: color}, nil
+}
+
+func (s *TagSpec) Name() string {
+	return s.name
+}
+
+func (s *TagSpec) Color() string {
+	return s.color
+}
+
+// Tag Task를 분류하는 태그입니다. 이름은 저장소 안에서 유일합니다.
+type Tag struct {
+	id    TagID
+	name  string
+	color string
+}
+
+func NewTag(id TagID, name, color string) *Tag {
+	return &Tag{id: id, name: name, color: color}
+}
+
+func (t *Tag) ID() TagID {
+	return t.id
+}
+
+func (t *Tag) Name() string {
+	return t.name
+}
+
+func (t *Tag) Color() string {
+	return t.color
+}
+
+func (t *Tag) Clone() *Tag {
+	return &Tag{id: t.id, name: t.name, color: t.color}
+}
+
+// SetSpec 이름과 색상을 spec으로 바꾼 새 Tag를 반환합니다.
+func (t *Tag) SetSpec(spec *TagSpec) *Tag {
+	return &Tag{id: t.id, name: spec.name, color: spec.color}
+}
+
+// cloneTags 태그를 복사하여 이름 순서로 정렬합니다.
+func cloneTags(tags []*Tag) []*Tag {
+	ret := make([]*Tag, len(tags))
+	for i, tag := range tags {
+		ret[i] = tag.Clone()
+	}
+
+	slices.SortFunc(ret, func(a, b *Tag) int {
+		return cmp.Compare(a.name, b.name)
+	})
+
+	return ret
+}
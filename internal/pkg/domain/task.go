@@ -1,15 +1,63 @@
 package domain
 
+import (
+	"slices"
+	"time"
+)
+
+// TaskSpecParams NewTaskSpec에 전달하는 사용자 입력입니다.
+// Priority가 비어 있으면 TaskPriorityMedium이고, DueDate가 nil이면 마감일이 없습니다.
+// ParentID가 비어 있으면 최상위 Task이고, ProjectID가 비어 있으면 프로젝트에 속하지 않습니다.
+type TaskSpecParams struct {
+	Title       string
+	Description string
+	Status      TaskStatus
+	Priority    TaskPriority
+	DueDate     *time.Time
+	ParentID    TaskID
+	ProjectID   ProjectID
+}
+
 type TaskSpec struct {
 	title       string
 	description string
+	status      TaskStatus
+	priority    TaskPriority
+	dueDate     *time.Time
+	parentID    TaskID
+	projectID   ProjectID
+	// priorityDefaulted 우선순위를 지정하지 않아 기본값이 적용되었는지를 나타냅니다.
+	priorityDefaulted bool
 }
 
-func NewTaskSpec(title, description string) *TaskSpec {
-	return &TaskSpec{
-		title:       title,
-		description: description,
+// NewTaskSpec 입력을 검증하여 TaskSpec을 생성합니다. 제목의 앞뒤 공백은 제거되며,
+// 규칙을 위반하면 필드별 정보를 담은 *ValidationError를 반환합니다.
+func NewTaskSpec(params TaskSpecParams) (*TaskSpec, error) {
+	var check validator
+
+	title := check.title(params.Title)
+	description := check.description(params.Description)
+
+	err := check.err()
+	if err != nil {
+		return nil, err
+	}
+
+	priority := params.Priority
+	if priority == "" {
+		priority = TaskPriorityMedium
 	}
+
+	return &TaskSpec{
+		title:             title,
+		description:       description,
+		status:            params.Status,
+		priority:          priority,
+		dueDate:           utcTime(params.DueDate),
+		parentID:          params.ParentID,
+		projectID:         params.ProjectID,
+		priorityDefaulted: params.Priority == "",
+	}, nil
 }
 
 func (s *TaskSpec) Title() string {
@@ -20,19 +68,105 @@ func (s *TaskSpec) Description() string {
 	return s.description
 }
 
+func (s *TaskSpec) Status() TaskStatus {
+	return s.status
+}
+
+func (s *TaskSpec) Priority() TaskPriority {
+	return s.priority
+}
+
+// DueDate 마감일입니다. 마감일이 없으면 nil입니다.
+func (s *TaskSpec) DueDate() *time.Time {
+	return copyTime(s.dueDate)
+}
+
+// ParentID 상위 Task의 ID입니다. 최상위 Task이면 빈 문자열입니다.
+func (s *TaskSpec) ParentID() TaskID {
+	return s.parentID
+}
+
+// ProjectID Task가 속할 프로젝트의 ID입니다. 프로젝트에 속하지 않으면 빈 문자열입니다.
+func (s *TaskSpec) ProjectID() ProjectID {
+	return s.projectID
+}
+
+// WithProjectDefaults 지정하지 않은 값을 프로젝트의 기본값으로 채운 새 TaskSpec을 반환합니다.
+func (s *TaskSpec) WithProjectDefaults(project *Project) *TaskSpec {
+	ret := *s
+	ret.dueDate = copyTime(s.dueDate)
+
+	if s.priorityDefaulted {
+		ret.priority = project.defaultPriority
+		ret.priorityDefaulted = false
+	}
+
+	return &ret
+}
+
 type TaskID string
 
+// TaskParams 저장소가 저장된 값으로 Task를 복원할 때 NewTask에 전달하는 필드입니다.
+type TaskParams struct {
+	ID          TaskID
+	Title       string
+	Description string
+	Status      TaskStatus
+	Priority    TaskPriority
+	DueDate     *time.Time
+	ParentID    TaskID
+	ProjectID   ProjectID
+	// Key 프로젝트 안에서 Task를 가리키는 키입니다(예: OPS-42). 프로젝트에 속하지 않으면 빈 문자열입니다.
+	Key string
+	// Rank 보드 열 안에서의 순서를 정하는 문자열로, 사전 순으로 비교합니다. 비어 있으면 ID로 정해집니다.
+	Rank string
+	Tags []*Tag
+	// Checklist 체크리스트 항목으로, 순서가 그대로 유지됩니다.
+	Checklist []ChecklistItem
+	Version   int
+	Created   Stamp
+	Updated   Stamp
+	// DeletedAt 휴지통에 있는 Task가 삭제된 시각입니다. 삭제되지 않았으면 nil입니다.
+	DeletedAt *time.Time
+}
+
 type Task struct {
 	id          TaskID
 	title       string
 	description string
+	status      TaskStatus
+	priority    TaskPriority
+	dueDate     *time.Time
+	parentID    TaskID
+	projectID   ProjectID
+	key         string
+	rank        string
+	tags        []*Tag
+	checklist   []ChecklistItem
+	version     int
+	created     Stamp
+	updated     Stamp
+	deletedAt   *time.Time
 }
 
-func NewTask(id TaskID, title, description string) *Task {
+func NewTask(params TaskParams) *Task {
 	return &Task{
-		id:          id,
-		title:       title,
-		description: description,
+		id:          params.ID,
+		title:       params.Title,
+		description: params.Description,
+		status:      params.Status,
+		priority:    params.Priority,
+		dueDate:     copyTime(params.DueDate),
+		parentID:    params.ParentID,
+		projectID:   params.ProjectID,
+		key:         params.Key,
+		rank:        initialRank(params.ID, params.Rank),
+		tags:        cloneTags(params.Tags),
+		checklist:   slices.Clone(params.Checklist),
+		version:     params.Version,
+		created:     params.Created,
+		updated:     params.Updated,
+		deletedAt:   copyTime(params.DeletedAt),
 	}
 }
 
@@ -48,18 +182,244 @@ func (t *Task) Description() string {
 	return t.description
 }
 
+func (t *Task) Status() TaskStatus {
+	return t.status
+}
+
+func (t *Task) Priority() TaskPriority {
+	return t.priority
+}
+
+// DueDate 마감일입니다. 마감일이 없으면 nil입니다.
+func (t *Task) DueDate() *time.Time {
+	return copyTime(t.dueDate)
+}
+
+// ParentID 상위 Task의 ID입니다. 최상위 Task이면 빈 문자열입니다.
+func (t *Task) ParentID() TaskID {
+	return t.parentID
+}
+
+// ProjectID Task가 속한 프로젝트의 ID입니다. 프로젝트에 속하지 않으면 빈 문자열입니다.
+// 프로젝트와 키는 생성할 때 정해지며 바뀌지 않습니다.
+func (t *Task) ProjectID() ProjectID {
+	return t.projectID
+}
+
+// Key 프로젝트 안에서 Task를 가리키는 키입니다(예: OPS-42). 프로젝트에 속하지 않으면 빈 문자열입니다.
+func (t *Task) Key() string {
+	return t.key
+}
+
+// Rank 보드 열 안에서의 순서입니다. 보드에서 옮기지 않은 Task는 만든 순서대로 놓입니다.
+func (t *Task) Rank() string {
+	return t.rank
+}
+
+// Tags 이름 순서로 정렬된 태그입니다.
+func (t *Task) Tags() []*Tag {
+	return cloneTags(t.tags)
+}
+
+// HasTag 태그가 붙어 있는지 확인합니다.
+func (t *Task) HasTag(id TagID) bool {
+	return slices.ContainsFunc(t.tags, func(tag *Tag) bool { return tag.id == id })
+}
+
+// Version 저장될 때마다 1씩 증가하는 버전으로, 낙관적 동시성 제어에 사용됩니다.
+func (t *Task) Version() int {
+	return t.version
+}
+
+// Created 생성 시각과 생성한 주체입니다.
+func (t *Task) Created() Stamp {
+	return t.created
+}
+
+// Updated 마지막 수정 시각과 수정한 주체입니다. 생성 직후에는 Created와 같습니다.
+func (t *Task) Updated() Stamp {
+	return t.updated
+}
+
+// DeletedAt 휴지통으로 옮겨진 시각입니다. 삭제되지 않은 Task이면 nil입니다.
+func (t *Task) DeletedAt() *time.Time {
+	return copyTime(t.deletedAt)
+}
+
+// IsOverdue now 기준으로 마감일이 지났지만 아직 끝나지 않은 Task인지 확인합니다.
+func (t *Task) IsOverdue(now time.Time) bool {
+	return t.dueDate != nil && t.dueDate.Before(now) && !t.status.IsClosed()
+}
+
 func (t *Task) Clone() *Task {
 	return &Task{
 		id:          t.id,
 		title:       t.title,
 		description: t.description,
+		status:      t.status,
+		priority:    t.priority,
+		dueDate:     copyTime(t.dueDate),
+		parentID:    t.parentID,
+		projectID:   t.projectID,
+		key:         t.key,
+		rank:        t.rank,
+		tags:        cloneTags(t.tags),
+		checklist:   slices.Clone(t.checklist),
+		version:     t.version,
+		created:     t.created,
+		updated:     t.updated,
+		deletedAt:   copyTime(t.deletedAt),
 	}
 }
 
+// Touch 마지막 수정 정보를 stamp로 바꾼 새 Task를 반환합니다.
+func (t *Task) Touch(stamp Stamp) *Task {
+	ret := t.Clone()
+	ret.updated = stamp
+
+	return ret
+}
+
+// WithVersion 버전만 바꾼 새 Task를 반환합니다. 저장소가 저장 후 증가한 버전을 반영할 때 사용합니다.
+func (t *Task) WithVersion(version int) *Task {
+	ret := t.Clone()
+	ret.version = version
+
+	return ret
+}
+
+// WithDeletedAt 삭제 시각을 at으로 바꾼 새 Task를 반환합니다. nil이면 휴지통에서 복원된 Task입니다.
+// 삭제와 복원은 Task의 내용이 아니므로 버전과 수정 정보는 바뀌지 않습니다.
+func (t *Task) WithDeletedAt(at *time.Time) *Task {
+	ret := t.Clone()
+	ret.deletedAt = copyTime(at)
+
+	return ret
+}
+
+// WithTags 태그를 tags로 바꾼 새 Task를 반환합니다.
+func (t *Task) WithTags(tags []*Tag) *Task {
+	ret := t.Clone()
+	ret.tags = cloneTags(tags)
+
+	return ret
+}
+
+// AddTag 태그를 붙인 새 Task를 반환합니다. 이미 붙어 있으면 최신 태그 정보로 바꿉니다.
+func (t *Task) AddTag(tag *Tag) *Task {
+	ret := t.RemoveTag(tag.id)
+	ret.tags = cloneTags(append(ret.tags, tag))
+
+	return ret
+}
+
+// RemoveTag 태그를 뗀 새 Task를 반환합니다.
+func (t *Task) RemoveTag(id TagID) *Task {
+	ret := t.Clone()
+	ret.tags = slices.DeleteFunc(ret.tags, func(tag *Tag) bool { return tag.id == id })
+
+	return ret
+}
+
+// WithParent 상위 Task를 parentID로 바꾼 새 Task를 반환합니다. 빈 ID는 최상위 Task를 뜻합니다.
+// 순환 여부는 저장된 다른 Task를 알아야 하므로 호출자가 확인해야 합니다.
+func (t *Task) WithParent(parentID TaskID) *Task {
+	ret := t.Clone()
+	ret.parentID = parentID
+
+	return ret
+}
+
+// SetSpec 상태와 상위 Task를 제외한 내용을 spec으로 바꿉니다. spec에 우선순위를 지정하지 않았으면 기본값으로
+// 되돌리지 않고 지금의 우선순위를 유지합니다. 상태는 Transition을, 상위 Task는 WithParent를 통해서만 변경됩니다.
 func (t *Task) SetSpec(spec *TaskSpec) *Task {
 	ret := t.Clone()
 	ret.title = spec.title
 	ret.description = spec.description
+	ret.dueDate = copyTime(spec.dueDate)
+
+	if !spec.priorityDefaulted {
+		ret.priority = spec.priority
+	}
 
 	return ret
 }
+
+// Spec Task의 내용을 TaskSpec으로 반환합니다. 저장된 값은 이미 검증되었으므로 다시 검증하지 않습니다.
+func (t *Task) Spec() *TaskSpec {
+	return &TaskSpec{
+		title:       t.title,
+		description: t.description,
+		status:      t.status,
+		priority:    t.priority,
+		dueDate:     copyTime(t.dueDate),
+		parentID:    t.parentID,
+		projectID:   t.projectID,
+		// 저장된 Task의 우선순위는 이미 정해진 값입니다.
+		priorityDefaulted: false,
+	}
+}
+
+// Transition 액션을 적용한 새 Task를 반환합니다. 허용되지 않는 전이는 ErrInvalidTransition을 반환합니다.
+func (t *Task) Transition(action TaskAction) (*Task, error) {
+	next, err := action.Next(t.status)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := t.Clone()
+	ret.status = next
+
+	return ret, nil
+}
+
+// ApplyPatch 패치에 지정된 필드만 변경한 새 Task를 반환합니다. 지정된 필드는 NewTaskSpec과 같은 규칙으로 검증합니다.
+func (t *Task) ApplyPatch(patch *TaskPatch) (*Task, error) {
+	var check validator
+
+	ret := t.Clone()
+
+	if patch.title != nil {
+		ret.title = check.title(*patch.title)
+	}
+
+	if patch.description != nil {
+		ret.description = check.description(*patch.description)
+	}
+
+	err := check.err()
+	if err != nil {
+		return nil, err
+	}
+
+	if patch.priority != nil {
+		ret.priority = *patch.priority
+	}
+
+	if patch.dueDateSet {
+		ret.dueDate = copyTime(patch.dueDate)
+	}
+
+	return ret, nil
+}
+
+// utcTime 저장소와 관계없이 같은 값으로 저장되고 비교되도록 시각을 UTC로 바꾸고 마이크로초 단위로 자릅니다.
+func utcTime(value *time.Time) *time.Time {
+	if value == nil {
+		return nil
+	}
+
+	ret := value.UTC().Truncate(time.Microsecond)
+
+	return &ret
+}
+
+func copyTime(value *time.Time) *time.Time {
+	if value == nil {
+		return nil
+	}
+
+	ret := *value
+
+	return &ret
+}
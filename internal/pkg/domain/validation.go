@@ -0,0 +1,269 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 필드 길이 제한으로, 바이트가 아닌 문자(rune) 수 기준입니다.
+const (
+	MaxTitleLength         = 200
+	MaxDescriptionLength   = 10000
+	MaxTagNameLength       = 50
+	MaxCommentLength       = 10000
+	MaxFileNameLength      = 255
+	MaxChecklistTextLength = 500
+	MaxProjectNameLength   = 100
+	MaxProjectKeyLength    = 10
+	MaxColumnKeyLength     = 30
+	MaxColumnNameLength    = 50
+)
+
+// 검증 규칙 코드로, API 응답의 필드 에러 코드로 그대로 노출됩니다.
+const (
+	ViolationRequired         = "required"
+	ViolationMaxLength        = "max_length"
+	ViolationControlCharacter = "control_character"
+	ViolationFormat           = "format"
+	ViolationMaxItems         = "max_items"
+	ViolationDuplicate        = "duplicate"
+)
+
+// Violation 필드 하나의 검증 실패 정보입니다. Param은 규칙의 기준 값(예: 최대 길이)입니다.
+type Violation struct {
+	Field string
+	Code  string
+	Param string
+}
+
+// ValidationError 하나 이상의 필드가 도메인 규칙을 위반했을 때 반환됩니다.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		fields[i] = violation.Field + " " + violation.Code
+	}
+
+	return fmt.Sprintf("%s: %s", ErrValidation, strings.Join(fields, ", "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+type validator struct {
+	violations []Violation
+}
+
+func (v *validator) add(field, code, param string) {
+	v.violations = append(v.violations, Violation{Field: field, Code: code, Param: param})
+}
+
+// title 앞뒤 공백을 제거한 제목을 검증하여 반환합니다.
+func (v *validator) title(title string) string {
+	title = strings.TrimSpace(title)
+
+	switch {
+	case title == "":
+		v.add("title", ViolationRequired, "")
+	case utf8.RuneCountInString(title) > MaxTitleLength:
+		v.add("title", ViolationMaxLength, strconv.Itoa(MaxTitleLength))
+	case strings.ContainsFunc(title, unicode.IsControl):
+		v.add("title", ViolationControlCharacter, "")
+	}
+
+	return title
+}
+
+// description 설명은 여러 줄을 허용하므로 줄바꿈과 탭을 제외한 제어 문자만 거부합니다.
+func (v *validator) description(description string) string {
+	switch {
+	case utf8.RuneCountInString(description) > MaxDescriptionLength:
+		v.add("description", ViolationMaxLength, strconv.Itoa(MaxDescriptionLength))
+	case strings.ContainsFunc(description, isDisallowedControl):
+		v.add("description", ViolationControlCharacter, "")
+	}
+
+	return description
+}
+
+// commentBody 앞뒤 공백을 제거한 댓글 본문을 검증하여 반환합니다. Markdown이므로 줄바꿈과 탭은 허용합니다.
+func (v *validator) commentBody(body string) string {
+	body = strings.TrimSpace(body)
+
+	switch {
+	case body == "":
+		v.add("body", ViolationRequired, "")
+	case utf8.RuneCountInString(body) > MaxCommentLength:
+		v.add("body", ViolationMaxLength, strconv.Itoa(MaxCommentLength))
+	case strings.ContainsFunc(body, isDisallowedControl):
+		v.add("body", ViolationControlCharacter, "")
+	}
+
+	return body
+}
+
+// fileName 앞뒤 공백을 제거한 첨부 파일 이름을 검증하여 반환합니다. 경로가 아닌 이름만 허용합니다.
+func (v *validator) fileName(name string) string {
+	name = strings.TrimSpace(name)
+
+	switch {
+	case name == "":
+		v.add("name", ViolationRequired, "")
+	case utf8.RuneCountInString(name) > MaxFileNameLength:
+		v.add("name", ViolationMaxLength, strconv.Itoa(MaxFileNameLength))
+	case strings.ContainsFunc(name, unicode.IsControl):
+		v.add("name", ViolationControlCharacter, "")
+	case name == "." || name == ".." || strings.ContainsAny(name, `/\`):
+		v.add("name", ViolationFormat, "")
+	}
+
+	return name
+}
+
+// checklistText 앞뒤 공백을 제거한 체크리스트 항목 내용을 검증하여 반환합니다. 한 줄이어야 합니다.
+func (v *validator) checklistText(text string) string {
+	text = strings.TrimSpace(text)
+
+	switch {
+	case text == "":
+		v.add("text", ViolationRequired, "")
+	case utf8.RuneCountInString(text) > MaxChecklistTextLength:
+		v.add("text", ViolationMaxLength, strconv.Itoa(MaxChecklistTextLength))
+	case strings.ContainsFunc(text, unicode.IsControl):
+		v.add("text", ViolationControlCharacter, "")
+	}
+
+	return text
+}
+
+// projectName 앞뒤 공백을 제거한 프로젝트 이름을 검증하여 반환합니다.
+func (v *validator) projectName(name string) string {
+	name = strings.TrimSpace(name)
+
+	switch {
+	case name == "":
+		v.add("name", ViolationRequired, "")
+	case utf8.RuneCountInString(name) > MaxProjectNameLength:
+		v.add("name", ViolationMaxLength, strconv.Itoa(MaxProjectNameLength))
+	case strings.ContainsFunc(name, unicode.IsControl):
+		v.add("name", ViolationControlCharacter, "")
+	}
+
+	return name
+}
+
+// projectKey 프로젝트 키를 대문자로 정규화하여 검증합니다. 영문 대문자로 시작하고 영문 대문자와 숫자만 허용하며,
+// Task 키와 구분되도록 두 글자 이상이어야 합니다.
+func (v *validator) projectKey(key string) string {
+	key = strings.ToUpper(strings.TrimSpace(key))
+
+	switch {
+	case key == "":
+		v.add("key", ViolationRequired, "")
+	case len(key) > MaxProjectKeyLength:
+		v.add("key", ViolationMaxLength, strconv.Itoa(MaxProjectKeyLength))
+	case len(key) < minProjectKeyLength || key[0] < 'A' || key[0] > 'Z' || strings.ContainsFunc(key, isNotKeyRune):
+		v.add("key", ViolationFormat, "")
+	}
+
+	return key
+}
+
+// columnKey 보드 열 키를 소문자로 정규화하여 검증합니다. 태그 이름과 같이 문자, 숫자, '-', '_'만 허용합니다.
+func (v *validator) columnKey(field, key string) string {
+	key = strings.ToLower(strings.TrimSpace(key))
+
+	switch {
+	case key == "":
+		v.add(field, ViolationRequired, "")
+	case utf8.RuneCountInString(key) > MaxColumnKeyLength:
+		v.add(field, ViolationMaxLength, strconv.Itoa(MaxColumnKeyLength))
+	case strings.ContainsFunc(key, isDisallowedTagRune):
+		v.add(field, ViolationFormat, "")
+	}
+
+	return key
+}
+
+// columnName 앞뒤 공백을 제거한 보드 열 이름을 검증하여 반환합니다.
+func (v *validator) columnName(field, name string) string {
+	name = strings.TrimSpace(name)
+
+	switch {
+	case name == "":
+		v.add(field, ViolationRequired, "")
+	case utf8.RuneCountInString(name) > MaxColumnNameLength:
+		v.add(field, ViolationMaxLength, strconv.Itoa(MaxColumnNameLength))
+	case strings.ContainsFunc(name, unicode.IsControl):
+		v.add(field, ViolationControlCharacter, "")
+	}
+
+	return name
+}
+
+// tagName 태그 이름을 소문자로 정규화하여 검증합니다. 문자, 숫자, '-', '_'만 허용합니다.
+func (v *validator) tagName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	switch {
+	case name == "":
+		v.add("name", ViolationRequired, "")
+	case utf8.RuneCountInString(name) > MaxTagNameLength:
+		v.add("name", ViolationMaxLength, strconv.Itoa(MaxTagNameLength))
+	case strings.ContainsFunc(name, isDisallowedTagRune):
+		v.add("name", ViolationFormat, "")
+	}
+
+	return name
+}
+
+// color 색상은 비어 있거나 "#rrggbb" 형식이어야 하며 소문자로 정규화됩니다.
+func (v *validator) color(color string) string {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if color == "" {
+		return color
+	}
+
+	hex, ok := strings.CutPrefix(color, "#")
+	if !ok || len(hex) != colorHexLength || strings.ContainsFunc(hex, isNotHexDigit) {
+		v.add("color", ViolationFormat, "")
+	}
+
+	return color
+}
+
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Violations: v.violations}
+}
+
+const (
+	colorHexLength      = 6
+	minProjectKeyLength = 2
+)
+
+func isDisallowedTagRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_'
+}
+
+func isNotKeyRune(r rune) bool {
+	return (r < 'A' || r > 'Z') && (r < '0' || r > '9')
+}
+
+func isNotHexDigit(r rune) bool {
+	return !strings.ContainsRune("0123456789abcdef", r)
+}
+
+func isDisallowedControl(r rune) bool {
+	return unicode.IsControl(r) && r != '\n' && r != '\r' && r != '\t'
+}
@@ -0,0 +1,214 @@
+package domain_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+func TestNewTaskSpec(t *testing.T) {
+	t.Parallel()
+
+	spec, err := domain.NewTaskSpec(domain.TaskSpecParams{ //nolint:exhaustruct
+		Title:       "  Write report \t",
+		Description: "line 1\r\nline 2\tend",
+	})
+	if err != nil {
+		t.Fatalf("NewTaskSpec: %v", err)
+	}
+
+	if spec.Title() != "Write report" || spec.Description() != "line 1\r\nline 2\tend" ||
+		spec.Priority() != domain.TaskPriorityMedium {
+		t.Errorf("NewTaskSpec = %q, %q, %q, want a trimmed title and the default priority",
+			spec.Title(), spec.Description(), spec.Priority())
+	}
+
+	tests := []struct {
+		name        string
+		title       string
+		description string
+		want        []domain.Violation
+	}{
+		{"blank title", " ", "", []domain.Violation{violation("title", domain.ViolationRequired, "")}},
+		{
+			"long title",
+			strings.Repeat("가", domain.MaxTitleLength+1),
+			"",
+			[]domain.Violation{violation("title", domain.ViolationMaxLength, "200")},
+		},
+		{"multi-line title", "a\nb", "", []domain.Violation{violation("title", domain.ViolationControlCharacter, "")}},
+		{
+			"long description",
+			"title",
+			strings.Repeat("a", domain.MaxDescriptionLength+1),
+			[]domain.Violation{violation("description", domain.ViolationMaxLength, "10000")},
+		},
+		{
+			"every field",
+			"",
+			"nul\x00",
+			[]domain.Violation{
+				violation("title", domain.ViolationRequired, ""),
+				violation("description", domain.ViolationControlCharacter, ""),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		_, err := domain.NewTaskSpec(domain.TaskSpecParams{Title: tc.title, Description: tc.description}) //nolint:exhaustruct
+		assertViolations(t, tc.name, err, tc.want)
+	}
+}
+
+// TestNewTaskSpecLengthInRunes 길이는 바이트가 아닌 문자 수로 셉니다.
+func TestNewTaskSpecLengthInRunes(t *testing.T) {
+	t.Parallel()
+
+	title := strings.Repeat("가", domain.MaxTitleLength)
+
+	_, err := domain.NewTaskSpec(domain.TaskSpecParams{Title: title}) //nolint:exhaustruct
+	if err != nil {
+		t.Errorf("NewTaskSpec with %d runes: %v", domain.MaxTitleLength, err)
+	}
+}
+
+func TestNewTagSpec(t *testing.T) {
+	t.Parallel()
+
+	spec, err := domain.NewTagSpec(" Front-End_2 ", " #3366FF ")
+	if err != nil || spec.Name() != "front-end_2" || spec.Color() != "#3366ff" {
+		t.Errorf("NewTagSpec = %v, %v, want a lowercase name and color", spec, err)
+	}
+
+	tests := []struct {
+		name, color string
+		want        []domain.Violation
+	}{
+		{"", "", []domain.Violation{violation("name", domain.ViolationRequired, "")}},
+		{
+			strings.Repeat("a", domain.MaxTagNameLength+1),
+			"",
+			[]domain.Violation{violation("name", domain.ViolationMaxLength, "50")},
+		},
+		{"front end", "", []domain.Violation{violation("name", domain.ViolationFormat, "")}},
+		{"work", "3366ff", []domain.Violation{violation("color", domain.ViolationFormat, "")}},
+		{"work", "#36f", []domain.Violation{violation("color", domain.ViolationFormat, "")}},
+		{"work", "#3366fg", []domain.Violation{violation("color", domain.ViolationFormat, "")}},
+		{
+			"a/b",
+			"red",
+			[]domain.Violation{violation("name", domain.ViolationFormat, ""), violation("color", domain.ViolationFormat, "")},
+		},
+	}
+
+	for _, tc := range tests {
+		_, err := domain.NewTagSpec(tc.name, tc.color)
+		assertViolations(t, tc.name+" "+tc.color, err, tc.want)
+	}
+}
+
+func TestNewAttachmentSpec(t *testing.T) {
+	t.Parallel()
+
+	spec, err := domain.NewAttachmentSpec("  report v2.pdf ")
+	if err != nil || spec.Name() != "report v2.pdf" {
+		t.Errorf("NewAttachmentSpec = %v, %v, want a trimmed name", spec, err)
+	}
+
+	tests := []struct {
+		name string
+		want []domain.Violation
+	}{
+		{"", []domain.Violation{violation("name", domain.ViolationRequired, "")}},
+		{
+			strings.Repeat("a", domain.MaxFileNameLength+1),
+			[]domain.Violation{violation("name", domain.ViolationMaxLength, "255")},
+		},
+		{"tab\tname", []domain.Violation{violation("name", domain.ViolationControlCharacter, "")}},
+		{".", []domain.Violation{violation("name", domain.ViolationFormat, "")}},
+		{"..", []domain.Violation{violation("name", domain.ViolationFormat, "")}},
+		{"dir/report.pdf", []domain.Violation{violation("name", domain.ViolationFormat, "")}},
+		{`dir\report.pdf`, []domain.Violation{violation("name", domain.ViolationFormat, "")}},
+	}
+
+	for _, tc := range tests {
+		_, err := domain.NewAttachmentSpec(tc.name)
+		assertViolations(t, tc.name, err, tc.want)
+	}
+}
+
+func TestParseProjectKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := domain.ParseProjectKey(" ops2 ")
+	if err != nil || key != "OPS2" {
+		t.Errorf("ParseProjectKey = %q, %v, want %q", key, err, "OPS2")
+	}
+
+	tests := []struct {
+		key  string
+		want []domain.Violation
+	}{
+		{"", []domain.Violation{violation("key", domain.ViolationRequired, "")}},
+		{"ABCDEFGHIJK", []domain.Violation{violation("key", domain.ViolationMaxLength, "10")}},
+		{"A", []domain.Violation{violation("key", domain.ViolationFormat, "")}},
+		{"2OPS", []domain.Violation{violation("key", domain.ViolationFormat, "")}},
+		{"OPS-1", []domain.Violation{violation("key", domain.ViolationFormat, "")}},
+	}
+
+	for _, tc := range tests {
+		_, err := domain.ParseProjectKey(tc.key)
+		assertViolations(t, tc.key, err, tc.want)
+	}
+}
+
+func TestNewProjectSpec(t *testing.T) {
+	t.Parallel()
+
+	params := domain.ProjectSpecParams{Name: " Operations ", Description: "", DefaultPriority: ""}
+
+	spec, err := domain.NewProjectSpec(params)
+	if err != nil || spec.Name() != "Operations" || spec.DefaultPriority() != domain.TaskPriorityMedium {
+		t.Errorf("NewProjectSpec = %v, %v, want a trimmed name and the default priority", spec, err)
+	}
+
+	_, err = domain.NewProjectSpec(domain.ProjectSpecParams{Name: "", Description: "nul\x00", DefaultPriority: ""})
+	assertViolations(t, "invalid project", err, []domain.Violation{
+		violation("name", domain.ViolationRequired, ""),
+		violation("description", domain.ViolationControlCharacter, ""),
+	})
+}
+
+func TestValidationError(t *testing.T) {
+	t.Parallel()
+
+	err := error(&domain.ValidationError{Violations: []domain.Violation{
+		violation("title", domain.ViolationRequired, ""),
+		violation("color", domain.ViolationFormat, ""),
+	}})
+
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("ValidationError does not wrap %v", domain.ErrValidation)
+	}
+
+	want := domain.ErrValidation.Error() + ": title required, color format"
+	if err.Error() != want {
+		t.Errorf("Error = %q, want %q", err.Error(), want)
+	}
+}
+
+func violation(field, code, param string) domain.Violation {
+	return domain.Violation{Field: field, Code: code, Param: param}
+}
+
+func assertViolations(t *testing.T, name string, err error, want []domain.Violation) {
+	t.Helper()
+
+	var validationErr *domain.ValidationError
+	if !errors.As(err, &validationErr) || !slices.Equal(validationErr.Violations, want) {
+		t.Errorf("%.30q: error = %v, want violations %v", name, err, want)
+	}
+}
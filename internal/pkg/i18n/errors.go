@@ -0,0 +1,7 @@
+package i18n
+
+import "errors"
+
+var (
+	ErrUnsupportedLanguage = errors.New("unsupported language")
+)
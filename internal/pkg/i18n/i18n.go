@@ -0,0 +1,146 @@
+// Package i18n API 응답 메시지의 다국어 카탈로그와 Accept-Language 협상을 제공합니다.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+//go:embed locales/*.json
+var locales embed.FS
+
+// Catalog 언어별 메시지 번들입니다. 번들에 없는 키는 기본 언어의 메시지를 사용합니다.
+type Catalog struct {
+	bundles  map[language.Tag]map[string]string
+	fallback language.Tag
+	// tags matcher에 전달한 언어 목록으로, Match가 반환하는 index와 순서가 같습니다.
+	tags    []language.Tag
+	matcher language.Matcher
+}
+
+// NewCatalog 내장된 번들을 읽어 Catalog를 생성합니다. fallback은 협상에 실패했을 때 사용할 언어입니다.
+func NewCatalog(fallback string) (*Catalog, error) {
+	fallbackTag, err := language.Parse(fallback)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fallback language %q: %w", fallback, err)
+	}
+
+	entries, err := locales.ReadDir("locales")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read locales: %w", err)
+	}
+
+	catalog := &Catalog{
+		bundles:  make(map[language.Tag]map[string]string),
+		fallback: fallbackTag,
+		// matcher는 첫 번째 태그를 기본값으로 사용하므로 fallback을 맨 앞에 둡니다.
+		tags:    []language.Tag{fallbackTag},
+		matcher: nil,
+	}
+
+	for _, entry := range entries {
+		tag, bundle, err := readBundle(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		catalog.bundles[tag] = bundle
+		if tag != fallbackTag {
+			catalog.tags = append(catalog.tags, tag)
+		}
+	}
+
+	if _, ok := catalog.bundles[fallbackTag]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedLanguage, fallback)
+	}
+
+	catalog.matcher = language.NewMatcher(catalog.tags)
+
+	return catalog, nil
+}
+
+func readBundle(name string) (language.Tag, map[string]string, error) {
+	tag, err := language.Parse(strings.TrimSuffix(name, path.Ext(name)))
+	if err != nil {
+		return language.Und, nil, fmt.Errorf("invalid locale file %s: %w", name, err)
+	}
+
+	data, err := locales.ReadFile("locales/" + name)
+	if err != nil {
+		return language.Und, nil, fmt.Errorf("failed to read locale %s: %w", name, err)
+	}
+
+	var bundle map[string]string
+
+	err = json.Unmarshal(data, &bundle)
+	if err != nil {
+		return language.Und, nil, fmt.Errorf("failed to decode locale %s: %w", name, err)
+	}
+
+	return tag, bundle, nil
+}
+
+// Negotiate Accept-Language 헤더 값에 가장 잘 맞는 언어의 Localizer를 반환합니다.
+func (c *Catalog) Negotiate(acceptLanguage string) *Localizer {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return c.Localizer(c.fallback)
+	}
+
+	_, index, confidence := c.matcher.Match(tags...)
+	if confidence == language.No {
+		return c.Localizer(c.fallback)
+	}
+
+	return c.Localizer(c.tags[index])
+}
+
+// Localizer 지정한 언어로 메시지를 찾는 Localizer를 반환합니다.
+func (c *Catalog) Localizer(tag language.Tag) *Localizer {
+	return &Localizer{catalog: c, tag: tag}
+}
+
+// Localizer 하나의 언어로 메시지를 조회합니다.
+type Localizer struct {
+	catalog *Catalog
+	tag     language.Tag
+}
+
+// Language 응답의 Content-Language 헤더에 사용할 언어 태그입니다.
+func (l *Localizer) Language() string {
+	return l.tag.String()
+}
+
+// Has key에 해당하는 메시지가 현재 언어나 기본 언어에 있는지 확인합니다.
+func (l *Localizer) Has(key string) bool {
+	_, ok := l.catalog.bundles[l.tag][key]
+	if !ok {
+		_, ok = l.catalog.bundles[l.catalog.fallback][key]
+	}
+
+	return ok
+}
+
+// Message key에 해당하는 메시지를 args로 포맷하여 반환합니다.
+// 메시지는 fmt 형식을 따르며, 언어별 어순을 위해 %[1]s와 같은 인덱스 지정자를 사용할 수 있습니다.
+func (l *Localizer) Message(key string, args ...any) string {
+	format, ok := l.catalog.bundles[l.tag][key]
+	if !ok {
+		format, ok = l.catalog.bundles[l.catalog.fallback][key]
+	}
+
+	if !ok {
+		return key
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+
+	return fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,116 @@
+package i18n_test
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/neatflowcv/tasker/internal/pkg/i18n"
+)
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := i18n.NewCatalog("en")
+	if err != nil {
+		t.Fatalf("NewCatalog: %v", err)
+	}
+
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{"", "en"},
+		{"ko", "ko"},
+		{"ko-KR,ko;q=0.9,en-US;q=0.8", "ko"},
+		{"en-US,en;q=0.9,ko;q=0.8", "en"},
+		{"fr-FR,fr;q=0.9", "en"},
+		{"fr;q=0.9,ko;q=0.5", "ko"},
+		{"not a language tag", "en"},
+	}
+
+	for _, tc := range tests {
+		if got := catalog.Negotiate(tc.acceptLanguage).Language(); got != tc.want {
+			t.Errorf("Negotiate(%q) = %q, want %q", tc.acceptLanguage, got, tc.want)
+		}
+	}
+}
+
+func TestMessage(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := i18n.NewCatalog("ko")
+	if err != nil {
+		t.Fatalf("NewCatalog: %v", err)
+	}
+
+	localizer := catalog.Negotiate("ko")
+
+	if got, want := localizer.Message("validation.required", "title"), "title은(는) 필수입니다"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+
+	if got, want := localizer.Message("unknown.key"), "unknown.key"; got != want {
+		t.Errorf("Message for unknown key = %q, want %q", got, want)
+	}
+
+	if got, want := catalog.Negotiate("fr").Language(), "ko"; got != want {
+		t.Errorf("fallback language = %q, want %q", got, want)
+	}
+}
+
+func TestNewCatalogUnsupportedFallback(t *testing.T) {
+	t.Parallel()
+
+	_, err := i18n.NewCatalog("fr")
+	if err == nil {
+		t.Fatal("NewCatalog(fr) succeeded, want error")
+	}
+}
+
+// TestBundlesHaveSameKeys 모든 언어 번들이 같은 메시지 키를 정의하는지 확인합니다.
+func TestBundlesHaveSameKeys(t *testing.T) {
+	t.Parallel()
+
+	locales := os.DirFS("locales")
+
+	paths, err := fs.Glob(locales, "*.json")
+	if err != nil {
+		t.Fatalf("Glob: %v", err)
+	}
+
+	var want []string
+
+	for _, path := range paths {
+		data, err := fs.ReadFile(locales, path)
+		if err != nil {
+			t.Fatalf("ReadFile: %v", err)
+		}
+
+		var bundle map[string]string
+
+		err = json.Unmarshal(data, &bundle)
+		if err != nil {
+			t.Fatalf("decode %s: %v", path, err)
+		}
+
+		keys := make([]string, 0, len(bundle))
+		for key := range bundle {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+
+		if want == nil {
+			want = keys
+
+			continue
+		}
+
+		if !slices.Equal(keys, want) {
+			t.Errorf("%s keys = %v, want %v", path, keys, want)
+		}
+	}
+}
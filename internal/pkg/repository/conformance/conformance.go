@@ -0,0 +1,2232 @@
+// Package conformance core.Repository 구현체가 공통으로 지켜야 하는 동작을 검사하는 테스트 모음입니다.
+//
+// 새 구현체는 자신의 테스트에서 Run을 호출하여 다른 구현체와 동일한 의미를 보장해야 합니다.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+const (
+	initialVersion = 1
+	updatedVersion = 2
+	// retaggedVersion 태그를 붙인 뒤 태그를 한 번 바꾸고 다른 태그를 삭제한 Task의 버전입니다.
+	retaggedVersion = 4
+	pageSize        = 3
+
+	creator = "creator"
+	editor  = "editor"
+)
+
+// Factory 각 테스트마다 비어 있는 새 Repository를 생성합니다.
+type Factory func(t *testing.T) core.Repository
+
+// Run 모든 conformance 테스트를 서브테스트로 실행합니다.
+func Run(t *testing.T, factory Factory) {
+	t.Helper()
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repo core.Repository)
+	}{
+		{"CreateTask", testCreateTask},
+		{"GetTask", testGetTask},
+		{"GetTaskNotFound", testGetTaskNotFound},
+		{"UpdateTask", testUpdateTask},
+		{"UpdateTaskNotFound", testUpdateTaskNotFound},
+		{"UpdateTaskConflict", testUpdateTaskConflict},
+		{"Stamps", testStamps},
+		{"DeleteTask", testDeleteTask},
+		{"DeleteTaskNotFound", testDeleteTaskNotFound},
+		{"ListTasksOrder", testListTasksOrder},
+		{"ListTasksPagination", testListTasksPagination},
+		{"ListTasksSort", testListTasksSort},
+		{"ListTasksSortByteOrder", testListTasksSortByteOrder},
+		{"ListTasksSortByTime", testListTasksSortByTime},
+		{"ListTasksFilter", testListTasksFilter},
+		{"PriorityAndDueDate", testPriorityAndDueDate},
+		{"ListTasksSortByPriorityAndDueDate", testListTasksSortByPriorityAndDueDate},
+		{"ListTasksFilterByPriorityAndDueDate", testListTasksFilterByPriorityAndDueDate},
+		{"DueDateTimeZones", testDueDateTimeZones},
+		{"Tags", testTags},
+		{"TaskTags", testTaskTags},
+		{"TagChangesTouchTasks", testTagChangesTouchTasks},
+		{"ListTasksFilterByTags", testListTasksFilterByTags},
+		{"Checklist", testChecklist},
+		{"Subtasks", testSubtasks},
+		{"Dependencies", testDependencies},
+		{"DependencyCycle", testDependencyCycle},
+		{"ListTasksFilterByIDs", testListTasksFilterByIDs},
+		{"Trash", testTrash},
+		{"PurgeDeletedTasks", testPurgeDeletedTasks},
+		{"PurgeDeletedTasksConcurrently", testPurgeDeletedTasksConcurrently},
+		{"PurgeParent", testPurgeParent},
+		{"AuditEntries", testAuditEntries},
+		{"AuditTrash", testAuditTrash},
+		{"ListAuditEntriesFilter", testListAuditEntriesFilter},
+		{"Revisions", testRevisions},
+		{"PurgeRevisions", testPurgeRevisions},
+		{"Comments", testComments},
+		{"DeleteCommentReplies", testDeleteCommentReplies},
+		{"PurgeComments", testPurgeComments},
+		{"Attachments", testAttachments},
+		{"AttachmentsInTrash", testAttachmentsInTrash},
+		{"Projects", testProjects},
+		{"ProjectTasks", testProjectTasks},
+		{"Board", testBoard},
+		{"CanceledContext", testCanceledContext},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.run(t, factory(t))
+		})
+	}
+}
+
+func testCreateTask(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	task := mustCreate(t, repo, "title", "description", domain.TaskStatusInProgress)
+
+	if task.ID() == "" {
+		t.Fatal("CreateTask returned an empty ID")
+	}
+
+	assertTask(t, task, "title", "description", domain.TaskStatusInProgress, initialVersion)
+
+	other := mustCreate(t, repo, "title", "description", domain.TaskStatusOpen)
+	if other.ID() == task.ID() {
+		t.Fatalf("CreateTask returned duplicate ID %q", task.ID())
+	}
+}
+
+func testGetTask(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	created := mustCreate(t, repo, "title", "", domain.TaskStatusOpen)
+
+	task, err := repo.GetTask(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+
+	if task.ID() != created.ID() {
+		t.Fatalf("GetTask ID = %q, want %q", task.ID(), created.ID())
+	}
+
+	assertTask(t, task, "title", "", domain.TaskStatusOpen, initialVersion)
+}
+
+func testGetTaskNotFound(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	_, err := repo.GetTask(t.Context(), "missing")
+	assertError(t, "GetTask", err, core.ErrTaskNotFound)
+}
+
+func testUpdateTask(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	created := mustCreate(t, repo, "title", "description", domain.TaskStatusOpen)
+
+	next, err := created.Transition(domain.TaskActionStart)
+	if err != nil {
+		t.Fatalf("Transition: %v", err)
+	}
+
+	updated, err := repo.UpdateTask(t.Context(), next.SetSpec(mustSpec(t, "new title", "", "")))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	assertTask(t, updated, "new title", "", domain.TaskStatusInProgress, updatedVersion)
+
+	stored, err := repo.GetTask(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+
+	assertTask(t, stored, "new title", "", domain.TaskStatusInProgress, updatedVersion)
+}
+
+func testUpdateTaskNotFound(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	missing := domain.NewTask(domain.TaskParams{
+		ID:          "missing",
+		Title:       "title",
+		Description: "",
+		Status:      domain.TaskStatusOpen,
+		Priority:    domain.TaskPriorityMedium,
+		DueDate:     nil,
+		ParentID:    "",
+		ProjectID:   "",
+		Key:         "",
+		Rank:        "",
+		Tags:        nil,
+		Checklist:   nil,
+		Version:     1,
+		Created:     stampAt(0, creator),
+		Updated:     stampAt(0, creator),
+		DeletedAt:   nil,
+	})
+
+	_, err := repo.UpdateTask(t.Context(), missing)
+	assertError(t, "UpdateTask", err, core.ErrTaskNotFound)
+
+	_, err = repo.GetTask(t.Context(), "missing")
+	assertError(t, "GetTask after UpdateTask", err, core.ErrTaskNotFound)
+}
+
+func testUpdateTaskConflict(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	created := mustCreate(t, repo, "title", "", domain.TaskStatusOpen)
+
+	_, err := repo.UpdateTask(t.Context(), created.SetSpec(mustSpec(t, "first", "", "")))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	_, err = repo.UpdateTask(t.Context(), created.SetSpec(mustSpec(t, "second", "", "")))
+	assertError(t, "UpdateTask with stale version", err, core.ErrConflict)
+
+	stored, err := repo.GetTask(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+
+	assertTask(t, stored, "first", "", domain.TaskStatusOpen, updatedVersion)
+}
+
+func testStamps(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	created := mustCreate(t, repo, "title", "", domain.TaskStatusOpen)
+	assertStamp(t, "Created", created.Created(), stampAt(0, creator))
+	assertStamp(t, "Updated", created.Updated(), stampAt(0, creator))
+
+	updated, err := repo.UpdateTask(t.Context(), created.Touch(stampAt(1, editor)))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	assertStamp(t, "Updated after UpdateTask", updated.Updated(), stampAt(1, editor))
+
+	stored, err := repo.GetTask(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+
+	assertStamp(t, "stored Created", stored.Created(), stampAt(0, creator))
+	assertStamp(t, "stored Updated", stored.Updated(), stampAt(1, editor))
+}
+
+func testDeleteTask(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	created := mustCreate(t, repo, "title", "", domain.TaskStatusOpen)
+
+	err := repo.DeleteTask(t.Context(), created.ID(), stampAt(1, ""))
+	if err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	_, err = repo.GetTask(t.Context(), created.ID())
+	assertError(t, "GetTask after DeleteTask", err, core.ErrTaskNotFound)
+
+	err = repo.DeleteTask(t.Context(), created.ID(), stampAt(1, ""))
+	assertError(t, "DeleteTask twice", err, core.ErrTaskNotFound)
+}
+
+func testDeleteTaskNotFound(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	err := repo.DeleteTask(t.Context(), "missing", stampAt(1, ""))
+	assertError(t, "DeleteTask", err, core.ErrTaskNotFound)
+}
+
+func testListTasksOrder(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	empty := mustList(t, repo, newQuery("", 0))
+	if len(empty.Tasks) != 0 || empty.NextCursor != nil {
+		t.Fatalf("ListTasks on empty repository = %d tasks, cursor %v", len(empty.Tasks), empty.NextCursor)
+	}
+
+	var want []domain.TaskID
+	for i := range 5 {
+		want = append(want, mustCreate(t, repo, fmt.Sprintf("task %d", i), "", domain.TaskStatusOpen).ID())
+	}
+
+	// ID는 생성 순서로 정렬되어야 합니다.
+	assertIDs(t, mustList(t, repo, newQuery("", 0)).Tasks, want)
+}
+
+func testListTasksPagination(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	var want []domain.TaskID
+	for i := range 7 {
+		want = append(want, mustCreate(t, repo, fmt.Sprintf("task %d", i), "", domain.TaskStatusOpen).ID())
+	}
+
+	var got []*domain.Task
+
+	query := newQuery("", pageSize)
+	for range want {
+		page := mustList(t, repo, query)
+		if len(page.Tasks) > query.Limit {
+			t.Fatalf("ListTasks returned %d tasks, limit %d", len(page.Tasks), query.Limit)
+		}
+
+		got = append(got, page.Tasks...)
+
+		if page.NextCursor == nil {
+			break
+		}
+
+		query.Cursor = page.NextCursor
+	}
+
+	assertIDs(t, got, want)
+}
+
+func testListTasksSort(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	charlie := mustCreate(t, repo, "charlie", "", domain.TaskStatusOpen).ID()
+	alpha := mustCreate(t, repo, "alpha", "", domain.TaskStatusDone).ID()
+	bravo1 := mustCreate(t, repo, "bravo", "", domain.TaskStatusOpen).ID()
+	bravo2 := mustCreate(t, repo, "bravo", "", domain.TaskStatusBlocked).ID()
+
+	assertIDs(t, mustList(t, repo, newQuery("title", 0)).Tasks, []domain.TaskID{alpha, bravo1, bravo2, charlie})
+	assertIDs(t, mustList(t, repo, newQuery("-title", 0)).Tasks, []domain.TaskID{charlie, bravo2, bravo1, alpha})
+	assertIDs(t, mustList(t, repo, newQuery("-id", 0)).Tasks, []domain.TaskID{bravo2, bravo1, alpha, charlie})
+
+	// 같은 정렬 값을 가진 Task 사이에서도 페이지 경계가 안정적이어야 합니다.
+	const limit = 2
+
+	first := mustList(t, repo, newQuery("title", limit))
+	assertIDs(t, first.Tasks, []domain.TaskID{alpha, bravo1})
+
+	query := newQuery("title", limit)
+	query.Cursor = first.NextCursor
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{bravo2, charlie})
+}
+
+// testListTasksSortByteOrder 제목은 대소문자나 기호, 로케일과 관계없이 바이트 순서로 정렬됩니다.
+func testListTasksSortByteOrder(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	apple := mustCreate(t, repo, "apple", "", domain.TaskStatusOpen).ID()
+	eclair := mustCreate(t, repo, "éclair", "", domain.TaskStatusOpen).ID()
+	zeta := mustCreate(t, repo, "_zeta", "", domain.TaskStatusOpen).ID()
+	banana := mustCreate(t, repo, "Banana", "", domain.TaskStatusOpen).ID()
+	zulu := mustCreate(t, repo, "Zulu", "", domain.TaskStatusOpen).ID()
+
+	want := []domain.TaskID{banana, zulu, zeta, apple, eclair}
+	assertIDs(t, mustList(t, repo, newQuery("title", 0)).Tasks, want)
+
+	// 커서 조건도 정렬과 같은 순서로 비교해야 페이지가 빠지거나 겹치지 않습니다.
+	const limit = 2
+
+	query := newQuery("title", limit)
+	first := mustList(t, repo, query)
+	assertIDs(t, first.Tasks, want[:limit])
+
+	query.Cursor = first.NextCursor
+	assertIDs(t, mustList(t, repo, query).Tasks, want[limit:2*limit])
+}
+
+func testListTasksSortByTime(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	const seconds = 3
+
+	var created []*domain.Task
+
+	for _, offset := range []int{2, 0, 1} {
+		task, err := repo.CreateTask(t.Context(), mustSpec(t, "title", "", domain.TaskStatusOpen), stampAt(offset, creator))
+		if err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+
+		created = append(created, task)
+	}
+
+	first, second, third := created[0].ID(), created[1].ID(), created[2].ID()
+	assertIDs(t, mustList(t, repo, newQuery("created_at", 0)).Tasks, []domain.TaskID{second, third, first})
+
+	_, err := repo.UpdateTask(t.Context(), created[1].Touch(stampAt(seconds, editor)))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	assertIDs(t, mustList(t, repo, newQuery("-updated_at", 0)).Tasks, []domain.TaskID{second, first, third})
+
+	page := mustList(t, repo, newQuery("-updated_at", 1))
+
+	query := newQuery("-updated_at", 0)
+	query.Cursor = page.NextCursor
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{first, third})
+}
+
+func testListTasksFilter(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	deploy := mustCreate(t, repo, "Deploy API", "", domain.TaskStatusOpen).ID()
+	review := mustCreate(t, repo, "Review deploy script", "", domain.TaskStatusDone).ID()
+	discount := mustCreate(t, repo, "100% discount_code", "", domain.TaskStatusOpen).ID()
+	other := mustCreate(t, repo, "Write docs", "", domain.TaskStatusBlocked).ID()
+
+	query := newQuery("", 0)
+	query.Filter.Statuses = []domain.TaskStatus{domain.TaskStatusOpen, domain.TaskStatusBlocked}
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{deploy, discount, other})
+
+	query = newQuery("", 0)
+	query.Filter.Search = "DEPLOY"
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{deploy, review})
+
+	query.Filter.Statuses = []domain.TaskStatus{domain.TaskStatusDone}
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{review})
+
+	// LIKE 와일드카드는 문자 그대로 검색되어야 합니다.
+	query = newQuery("", 0)
+	query.Filter.Search = "0% discount_"
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{discount})
+
+	query.Filter.Search = "_"
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{discount})
+}
+
+func testPriorityAndDueDate(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	dueDate := stampAt(seconds(time.Hour), "").At()
+
+	created := mustCreatePlanned(t, repo, domain.TaskStatusOpen, domain.TaskPriorityUrgent, &dueDate)
+	if created.Priority() != domain.TaskPriorityUrgent || !equalTime(created.DueDate(), &dueDate) {
+		t.Fatalf("created priority %q due %v", created.Priority(), created.DueDate())
+	}
+
+	spec := mustSpecWith(t, domain.TaskSpecParams{
+		Title:       "title",
+		Description: "",
+		Status:      "",
+		Priority:    domain.TaskPriorityLow,
+		DueDate:     nil,
+		ParentID:    "",
+		ProjectID:   "",
+	})
+
+	_, err := repo.UpdateTask(t.Context(), created.SetSpec(spec))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	stored, err := repo.GetTask(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+
+	if stored.Priority() != domain.TaskPriorityLow || stored.DueDate() != nil {
+		t.Fatalf("stored priority %q due %v, want low without due date", stored.Priority(), stored.DueDate())
+	}
+}
+
+func testListTasksSortByPriorityAndDueDate(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	early := stampAt(seconds(time.Hour), "").At()
+	late := early.Add(time.Hour)
+
+	none := mustCreatePlanned(t, repo, domain.TaskStatusOpen, domain.TaskPriorityHigh, nil).ID()
+	lateLow := mustCreatePlanned(t, repo, domain.TaskStatusOpen, domain.TaskPriorityLow, &late).ID()
+	earlyUrgent := mustCreatePlanned(t, repo, domain.TaskStatusOpen, domain.TaskPriorityUrgent, &early).ID()
+	earlyHigh := mustCreatePlanned(t, repo, domain.TaskStatusOpen, domain.TaskPriorityHigh, &early).ID()
+
+	assertIDs(t, mustList(t, repo, newQuery("-priority", 0)).Tasks,
+		[]domain.TaskID{earlyUrgent, earlyHigh, none, lateLow})
+
+	// 마감일이 없는 Task는 오름차순에서 가장 뒤에 옵니다.
+	want := []domain.TaskID{earlyUrgent, earlyHigh, lateLow, none}
+	assertIDs(t, mustList(t, repo, newQuery("due_date", 0)).Tasks, want)
+
+	var got []*domain.Task
+
+	query := newQuery("due_date", 1)
+	for range want {
+		page := mustList(t, repo, query)
+		got = append(got, page.Tasks...)
+
+		if page.NextCursor == nil {
+			break
+		}
+
+		query.Cursor = page.NextCursor
+	}
+
+	assertIDs(t, got, want)
+}
+
+func testListTasksFilterByPriorityAndDueDate(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	now := stampAt(seconds(time.Hour), "").At()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	overdue := mustCreatePlanned(t, repo, domain.TaskStatusInProgress, domain.TaskPriorityHigh, &past).ID()
+	closed := mustCreatePlanned(t, repo, domain.TaskStatusDone, domain.TaskPriorityHigh, &past).ID()
+	upcoming := mustCreatePlanned(t, repo, domain.TaskStatusOpen, domain.TaskPriorityLow, &future).ID()
+	unplanned := mustCreatePlanned(t, repo, domain.TaskStatusOpen, domain.TaskPriorityLow, nil).ID()
+
+	query := newQuery("", 0)
+	query.Filter.Priorities = []domain.TaskPriority{domain.TaskPriorityLow}
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{upcoming, unplanned})
+
+	query = newQuery("", 0)
+	query.Filter.DueBefore = &now
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{overdue, closed})
+
+	query = newQuery("", 0)
+	query.Filter.DueAfter = &now
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{upcoming})
+
+	query = newQuery("", 0)
+	query.Filter.OverdueAt = &now
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{overdue})
+}
+
+// testDueDateTimeZones UTC가 아닌 시간대로 받은 마감일도 시각 순서대로 정렬하고 비교하는지 확인합니다.
+// 시간대를 그대로 문자열로 저장하면 soon이 later보다 뒤에 옵니다.
+func testDueDateTimeZones(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	const (
+		soonIn    = 30 * time.Minute
+		between   = 45 * time.Minute
+		laterIn   = time.Hour
+		kstOffset = 9 * time.Hour
+		hstOffset = -10 * time.Hour
+	)
+
+	now := stampAt(seconds(time.Hour), "").At()
+	soonAt := now.Add(soonIn).In(time.FixedZone("KST", seconds(kstOffset)))
+	laterAt := now.Add(laterIn).In(time.FixedZone("HST", seconds(hstOffset)))
+
+	soon := mustCreatePlanned(t, repo, domain.TaskStatusOpen, domain.TaskPriorityLow, &soonAt)
+	later := mustCreatePlanned(t, repo, domain.TaskStatusOpen, domain.TaskPriorityLow, &laterAt).ID()
+
+	if !equalTime(soon.DueDate(), &soonAt) || soon.DueDate().Location() != time.UTC {
+		t.Fatalf("created due %v, want %v in UTC", soon.DueDate(), soonAt)
+	}
+
+	assertIDs(t, mustList(t, repo, newQuery("due_date", 0)).Tasks, []domain.TaskID{soon.ID(), later})
+
+	dueAt := now.Add(between)
+
+	query := newQuery("", 0)
+	query.Filter.DueBefore = &dueAt
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{soon.ID()})
+
+	query = newQuery("", 0)
+	query.Filter.DueAfter = &dueAt
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{later})
+}
+
+func testTags(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	work := mustCreateTag(t, repo, "work", "#ff0000")
+	home := mustCreateTag(t, repo, "home", "")
+
+	_, err := repo.CreateTag(t.Context(), mustTagSpec(t, "Work", ""))
+	assertError(t, "CreateTag with duplicate name", err, core.ErrDuplicateTag)
+
+	tags, err := repo.ListTags(t.Context())
+	if err != nil {
+		t.Fatalf("ListTags: %v", err)
+	}
+
+	if got := tagNames(tags); got != "[home work]" {
+		t.Fatalf("ListTags = %s, want [home work]", got)
+	}
+
+	_, err = repo.UpdateTag(t.Context(), home.SetSpec(mustTagSpec(t, "work", "")), stampAt(1, editor))
+	assertError(t, "UpdateTag with duplicate name", err, core.ErrDuplicateTag)
+
+	_, err = repo.UpdateTag(t.Context(), work.SetSpec(mustTagSpec(t, "office", "#00ff00")), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("UpdateTag: %v", err)
+	}
+
+	stored, err := repo.GetTag(t.Context(), work.ID())
+	if err != nil {
+		t.Fatalf("GetTag: %v", err)
+	}
+
+	if stored.Name() != "office" || stored.Color() != "#00ff00" {
+		t.Fatalf("GetTag = %s %s, want office #00ff00", stored.Name(), stored.Color())
+	}
+
+	err = repo.DeleteTag(t.Context(), work.ID(), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("DeleteTag: %v", err)
+	}
+
+	_, err = repo.GetTag(t.Context(), work.ID())
+	assertError(t, "GetTag after DeleteTag", err, core.ErrTagNotFound)
+
+	_, err = repo.UpdateTag(t.Context(), work, stampAt(1, editor))
+	assertError(t, "UpdateTag after DeleteTag", err, core.ErrTagNotFound)
+
+	err = repo.DeleteTag(t.Context(), work.ID(), stampAt(1, editor))
+	assertError(t, "DeleteTag after DeleteTag", err, core.ErrTagNotFound)
+}
+
+func testTaskTags(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	work := mustCreateTag(t, repo, "work", "")
+	home := mustCreateTag(t, repo, "home", "")
+	created := mustCreate(t, repo, "title", "", domain.TaskStatusOpen)
+
+	updated, err := repo.UpdateTask(t.Context(), created.AddTag(work).AddTag(home))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	if got := tagNames(updated.Tags()); got != "[home work]" {
+		t.Fatalf("UpdateTask tags = %s, want [home work]", got)
+	}
+
+	_, err = repo.UpdateTag(t.Context(), work.SetSpec(mustTagSpec(t, "office", "")), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("UpdateTag: %v", err)
+	}
+
+	err = repo.DeleteTag(t.Context(), home.ID(), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("DeleteTag: %v", err)
+	}
+
+	// 태그를 바꾸거나 삭제할 때마다 태그가 붙은 Task의 버전이 올라갑니다.
+	stored, err := repo.GetTask(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+
+	if got := tagNames(stored.Tags()); got != "[office]" || stored.Version() != retaggedVersion {
+		t.Fatalf("GetTask tags = %s version %d, want [office] version %d", got, stored.Version(), retaggedVersion)
+	}
+
+	_, err = repo.UpdateTask(t.Context(), stored.AddTag(home))
+	assertError(t, "UpdateTask with deleted tag", err, core.ErrTagNotFound)
+
+	cleared, err := repo.UpdateTask(t.Context(), stored.RemoveTag(work.ID()))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	if len(cleared.Tags()) != 0 {
+		t.Fatalf("UpdateTask tags = %s, want []", tagNames(cleared.Tags()))
+	}
+}
+
+// testTagChangesTouchTasks 태그를 바꾸거나 삭제하면 태그가 붙은 Task만 stamp로 수정되어 리비전과 감사 기록이
+// 남는지 확인합니다.
+func testTagChangesTouchTasks(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	tag := mustCreateTag(t, repo, "work", "")
+	tagged := mustTag(t, repo, mustCreate(t, repo, "tagged", "", domain.TaskStatusOpen), tag)
+	other := mustCreate(t, repo, "other", "", domain.TaskStatusOpen)
+
+	_, err := repo.UpdateTag(t.Context(), tag.SetSpec(mustTagSpec(t, "office", "")), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("UpdateTag: %v", err)
+	}
+
+	renamed := mustListRevisions(t, repo, tagged.ID())
+	if latest := renamed[len(renamed)-1]; latest.Version() != tagged.Version()+1 || tagNames(latest.Tags()) != "[office]" {
+		t.Fatalf("latest revision = version %d tags %s, want version %d tags [office]",
+			latest.Version(), tagNames(latest.Tags()), tagged.Version()+1)
+	}
+
+	assertStamp(t, "renamed revision updated", renamed[len(renamed)-1].Updated(), stampAt(1, editor))
+
+	err = repo.DeleteTag(t.Context(), tag.ID(), stampAt(1, creator))
+	if err != nil {
+		t.Fatalf("DeleteTag: %v", err)
+	}
+
+	stored := mustGetTask(t, repo, tagged.ID())
+	if len(stored.Tags()) != 0 || stored.Version() != len(renamed)+1 {
+		t.Fatalf("GetTask = tags %s version %d, want no tags at version %d",
+			tagNames(stored.Tags()), stored.Version(), len(renamed)+1)
+	}
+
+	if len(mustListRevisions(t, repo, stored.ID())) != stored.Version() {
+		t.Fatalf("ListRevisions after DeleteTag does not end at version %d", stored.Version())
+	}
+
+	// 생성 기록과 버전이 오를 때마다 남은 수정 기록입니다.
+	query := newAuditQuery(0)
+	query.TaskID = tagged.ID()
+
+	if page := mustListAudit(t, repo, query); len(page.Entries) != stored.Version() {
+		t.Fatalf("ListAuditEntries = %d entries, want %d", len(page.Entries), stored.Version())
+	}
+
+	if untouched := mustGetTask(t, repo, other.ID()); untouched.Version() != initialVersion {
+		t.Fatalf("GetTask of an untagged task = version %d, want %d", untouched.Version(), initialVersion)
+	}
+}
+
+func testChecklist(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	created := mustCreate(t, repo, "title", "", domain.TaskStatusOpen)
+	if created.Checklist() != nil {
+		t.Fatalf("CreateTask checklist = %v, want none", created.Checklist())
+	}
+
+	checklist := []domain.ChecklistItem{
+		domain.NewChecklistItem("second", "두 번째", true),
+		domain.NewChecklistItem("first", "first", false),
+	}
+
+	updated, err := repo.UpdateTask(t.Context(), created.WithChecklist(checklist))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	stored, err := repo.GetTask(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+
+	if !reflect.DeepEqual(stored.Checklist(), checklist) {
+		t.Fatalf("GetTask checklist = %v, want %v in order", stored.Checklist(), checklist)
+	}
+
+	revision, err := repo.GetRevision(t.Context(), created.ID(), updated.Version())
+	if err != nil {
+		t.Fatalf("GetRevision: %v", err)
+	}
+
+	if !reflect.DeepEqual(revision.Checklist(), checklist) {
+		t.Fatalf("revision checklist = %v, want %v", revision.Checklist(), checklist)
+	}
+
+	cleared, err := repo.UpdateTask(t.Context(), stored.WithChecklist(nil))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	if cleared.Checklist() != nil {
+		t.Fatalf("UpdateTask checklist = %v, want none", cleared.Checklist())
+	}
+}
+
+func testListTasksFilterByTags(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	work := mustCreateTag(t, repo, "work", "")
+	urgent := mustCreateTag(t, repo, "urgent", "")
+
+	both := mustTag(t, repo, mustCreate(t, repo, "both", "", domain.TaskStatusOpen), work, urgent).ID()
+	workOnly := mustTag(t, repo, mustCreate(t, repo, "work", "", domain.TaskStatusOpen), work).ID()
+	mustCreate(t, repo, "untagged", "", domain.TaskStatusOpen)
+
+	query := newQuery("", 0)
+	query.Filter.Tags = []string{"work", "urgent"}
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{both, workOnly})
+
+	query.Filter.TagMatch = core.TagMatchAll
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{both})
+
+	query = newQuery("", 0)
+	query.Filter.Tags = []string{"missing"}
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{})
+}
+
+func testSubtasks(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	parent := mustCreate(t, repo, "parent", "", domain.TaskStatusOpen)
+
+	created, err := repo.CreateTask(t.Context(), mustSpecWith(t, domain.TaskSpecParams{
+		Title:       "child",
+		Description: "",
+		Status:      domain.TaskStatusOpen,
+		Priority:    "",
+		DueDate:     nil,
+		ParentID:    parent.ID(),
+		ProjectID:   "",
+	}), stampAt(0, creator))
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	if created.ParentID() != parent.ID() {
+		t.Fatalf("ParentID = %q, want %q", created.ParentID(), parent.ID())
+	}
+
+	other := mustCreate(t, repo, "other", "", domain.TaskStatusOpen)
+
+	query := newQuery("", 0)
+	query.Filter.ParentID = parent.ID()
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{created.ID()})
+
+	_, err = repo.UpdateTask(t.Context(), created.WithParent(other.ID()))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{})
+
+	query.Filter.ParentID = other.ID()
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{created.ID()})
+}
+
+func testDependencies(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	first := mustCreate(t, repo, "first", "", domain.TaskStatusOpen).ID()
+	second := mustCreate(t, repo, "second", "", domain.TaskStatusOpen).ID()
+	third := mustCreate(t, repo, "third", "", domain.TaskStatusOpen).ID()
+
+	for _, dep := range []domain.Dependency{
+		domain.NewDependency(second, first),
+		domain.NewDependency(third, first),
+		domain.NewDependency(third, second),
+		domain.NewDependency(third, second),
+	} {
+		err := repo.AddDependency(t.Context(), dep)
+		if err != nil {
+			t.Fatalf("AddDependency(%v): %v", dep, err)
+		}
+	}
+
+	err := repo.AddDependency(t.Context(), domain.NewDependency(first, "missing"))
+	assertError(t, "AddDependency with missing task", err, core.ErrTaskNotFound)
+
+	assertDependencies(t, repo, []domain.TaskID{third, second}, []domain.Dependency{
+		domain.NewDependency(second, first),
+		domain.NewDependency(third, first),
+		domain.NewDependency(third, second),
+	})
+
+	dependents, err := repo.ListDependents(t.Context(), first)
+	if err != nil {
+		t.Fatalf("ListDependents: %v", err)
+	}
+
+	want := []domain.Dependency{domain.NewDependency(second, first), domain.NewDependency(third, first)}
+	if fmt.Sprint(dependents) != fmt.Sprint(want) {
+		t.Fatalf("ListDependents = %v", dependents)
+	}
+
+	err = repo.RemoveDependency(t.Context(), domain.NewDependency(third, first))
+	if err != nil {
+		t.Fatalf("RemoveDependency: %v", err)
+	}
+
+	err = repo.RemoveDependency(t.Context(), domain.NewDependency(third, first))
+	assertError(t, "RemoveDependency after RemoveDependency", err, core.ErrDependencyNotFound)
+
+	// 휴지통으로 옮긴 Task의 의존성은 복원할 수 있도록 남고, 영구 삭제하면 사라집니다.
+	err = repo.DeleteTask(t.Context(), second, stampAt(1, ""))
+	if err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	assertDependencies(t, repo, []domain.TaskID{third}, []domain.Dependency{domain.NewDependency(third, second)})
+
+	err = repo.PurgeTask(t.Context(), second, stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("PurgeTask: %v", err)
+	}
+
+	assertDependencies(t, repo, []domain.TaskID{first, second, third}, []domain.Dependency{})
+}
+
+// testDependencyCycle 순환이 생기는 의존성은 동시에 추가하더라도 하나만 저장되어야 합니다.
+func testDependencyCycle(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	first := mustCreate(t, repo, "first", "", domain.TaskStatusOpen).ID()
+	second := mustCreate(t, repo, "second", "", domain.TaskStatusOpen).ID()
+	third := mustCreate(t, repo, "third", "", domain.TaskStatusOpen).ID()
+
+	for _, dep := range []domain.Dependency{domain.NewDependency(second, first), domain.NewDependency(third, second)} {
+		err := repo.AddDependency(t.Context(), dep)
+		if err != nil {
+			t.Fatalf("AddDependency(%v): %v", dep, err)
+		}
+	}
+
+	err := repo.AddDependency(t.Context(), domain.NewDependency(first, third))
+	assertError(t, "AddDependency closing a cycle", err, domain.ErrDependencyCycle)
+
+	err = repo.AddDependency(t.Context(), domain.NewDependency(first, first))
+	assertError(t, "AddDependency on itself", err, domain.ErrDependencyCycle)
+
+	for round := range pageSize {
+		task := mustCreate(t, repo, fmt.Sprintf("task %d", round), "", domain.TaskStatusOpen).ID()
+		other := mustCreate(t, repo, fmt.Sprintf("other %d", round), "", domain.TaskStatusOpen).ID()
+		deps := [2]domain.Dependency{domain.NewDependency(task, other), domain.NewDependency(other, task)}
+
+		var (
+			wg   sync.WaitGroup
+			errs [len(deps)]error
+		)
+
+		for i, dep := range deps {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				errs[i] = repo.AddDependency(t.Context(), dep)
+			}()
+		}
+
+		wg.Wait()
+
+		if (errs[0] == nil) == (errs[1] == nil) ||
+			!errors.Is(errs[0], domain.ErrDependencyCycle) && !errors.Is(errs[1], domain.ErrDependencyCycle) {
+			t.Fatalf("AddDependency concurrently = %v, want one to fail with %v", errs, domain.ErrDependencyCycle)
+		}
+
+		stored, err := repo.ListDependencies(t.Context(), []domain.TaskID{task, other})
+		if err != nil || len(stored) != 1 {
+			t.Fatalf("ListDependencies after concurrent AddDependency = %v, %v; want one", stored, err)
+		}
+	}
+}
+
+func testListTasksFilterByIDs(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	first := mustCreate(t, repo, "first", "", domain.TaskStatusOpen).ID()
+	mustCreate(t, repo, "second", "", domain.TaskStatusOpen)
+	third := mustCreate(t, repo, "third", "", domain.TaskStatusOpen).ID()
+
+	query := newQuery("", 0)
+	query.Filter.IDs = []domain.TaskID{third, first, "missing"}
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{first, third})
+}
+
+func testTrash(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	kept := mustCreate(t, repo, "kept", "", domain.TaskStatusOpen)
+	trashed := mustCreate(t, repo, "trashed", "", domain.TaskStatusOpen)
+
+	err := repo.DeleteTask(t.Context(), trashed.ID(), stampAt(1, ""))
+	if err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	assertIDs(t, mustList(t, repo, newQuery("", 0)).Tasks, []domain.TaskID{kept.ID()})
+
+	query := newQuery("", 0)
+	query.Filter.Deleted = true
+
+	trash := mustList(t, repo, query).Tasks
+	assertIDs(t, trash, []domain.TaskID{trashed.ID()})
+
+	if deletedAt := stampAt(1, "").At(); !equalTime(trash[0].DeletedAt(), &deletedAt) {
+		t.Fatalf("DeletedAt = %v, want %v", trash[0].DeletedAt(), deletedAt)
+	}
+
+	_, err = repo.UpdateTask(t.Context(), trashed)
+	assertError(t, "UpdateTask in trash", err, core.ErrTaskNotFound)
+
+	err = repo.AddDependency(t.Context(), domain.NewDependency(kept.ID(), trashed.ID()))
+	assertError(t, "AddDependency on task in trash", err, core.ErrTaskNotFound)
+
+	_, err = repo.RestoreTask(t.Context(), kept.ID(), stampAt(1, editor))
+	assertError(t, "RestoreTask outside trash", err, core.ErrTaskNotFound)
+
+	err = repo.PurgeTask(t.Context(), kept.ID(), stampAt(1, editor))
+	assertError(t, "PurgeTask outside trash", err, core.ErrTaskNotFound)
+
+	restored, err := repo.RestoreTask(t.Context(), trashed.ID(), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("RestoreTask: %v", err)
+	}
+
+	if restored.DeletedAt() != nil || restored.Version() != initialVersion {
+		t.Fatalf("restored task = %+v, want live task at version %d", restored, initialVersion)
+	}
+
+	stored, err := repo.GetTask(t.Context(), trashed.ID())
+	if err != nil {
+		t.Fatalf("GetTask after RestoreTask: %v", err)
+	}
+
+	assertTask(t, stored, "trashed", "", domain.TaskStatusOpen, initialVersion)
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{})
+}
+
+func testPurgeDeletedTasks(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	tag := mustCreateTag(t, repo, "tag", "")
+	old := mustTag(t, repo, mustCreate(t, repo, "old", "", domain.TaskStatusOpen), tag)
+	recent := mustCreate(t, repo, "recent", "", domain.TaskStatusOpen)
+
+	for i, id := range []domain.TaskID{old.ID(), recent.ID()} {
+		err := repo.DeleteTask(t.Context(), id, stampAt(i, ""))
+		if err != nil {
+			t.Fatalf("DeleteTask: %v", err)
+		}
+	}
+
+	purged, err := repo.PurgeDeletedTasks(t.Context(), stampAt(1, "").At(), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("PurgeDeletedTasks: %v", err)
+	}
+
+	if fmt.Sprint(purged) != fmt.Sprint([]domain.TaskID{old.ID()}) {
+		t.Fatalf("PurgeDeletedTasks = %v, want [%s]", purged, old.ID())
+	}
+
+	_, err = repo.RestoreTask(t.Context(), old.ID(), stampAt(1, editor))
+	assertError(t, "RestoreTask after purge", err, core.ErrTaskNotFound)
+
+	query := newQuery("", 0)
+	query.Filter.Deleted = true
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{recent.ID()})
+
+	purged, err = repo.PurgeDeletedTasks(t.Context(), stampAt(1, "").At(), stampAt(1, editor))
+	if err != nil || len(purged) != 0 {
+		t.Fatalf("PurgeDeletedTasks again = %v, %v, want nothing purged", purged, err)
+	}
+}
+
+// testPurgeDeletedTasksConcurrently 여러 인스턴스가 동시에 휴지통을 비워도 각 Task를 한 번만 영구 삭제하고
+// 한 번만 감사 기록을 남기는지 검사합니다.
+func testPurgeDeletedTasksConcurrently(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	const purgers = 4
+
+	want := make([]domain.TaskID, pageSize)
+
+	for i := range want {
+		want[i] = mustCreate(t, repo, fmt.Sprintf("task %d", i), "", domain.TaskStatusOpen).ID()
+
+		err := repo.DeleteTask(t.Context(), want[i], stampAt(0, ""))
+		if err != nil {
+			t.Fatalf("DeleteTask: %v", err)
+		}
+	}
+
+	var (
+		wg      sync.WaitGroup
+		results [purgers][]domain.TaskID
+		errs    [purgers]error
+	)
+
+	for i := range purgers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i], errs[i] = repo.PurgeDeletedTasks(t.Context(), stampAt(1, "").At(), stampAt(1, editor))
+		}()
+	}
+
+	wg.Wait()
+
+	var purged []domain.TaskID
+
+	for i := range purgers {
+		if errs[i] != nil {
+			t.Fatalf("PurgeDeletedTasks: %v", errs[i])
+		}
+
+		purged = append(purged, results[i]...)
+	}
+
+	slices.Sort(purged)
+	slices.Sort(want)
+
+	if fmt.Sprint(purged) != fmt.Sprint(want) {
+		t.Fatalf("PurgeDeletedTasks concurrently = %v, want each of %v once", purged, want)
+	}
+
+	for _, id := range want {
+		query := newAuditQuery(0)
+		query.TaskID = id
+
+		entries := mustListAudit(t, repo, query).Entries
+		if len(entries) != 3 || entries[2].Action() != domain.AuditActionPurge {
+			t.Fatalf("audit entries of %s = %v, want create, delete and one purge", id, entries)
+		}
+	}
+}
+
+func testPurgeParent(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	parent := mustCreate(t, repo, "parent", "", domain.TaskStatusOpen)
+	trashed := mustCreateSubtask(t, repo, "trashed", parent.ID())
+	live := mustCreateSubtask(t, repo, "live", parent.ID())
+
+	// 하위 Task를 함께 삭제할 때처럼 가장 아래의 Task부터 휴지통으로 옮깁니다.
+	for _, id := range []domain.TaskID{trashed.ID(), parent.ID()} {
+		err := repo.DeleteTask(t.Context(), id, stampAt(1, ""))
+		if err != nil {
+			t.Fatalf("DeleteTask: %v", err)
+		}
+	}
+
+	err := repo.PurgeTask(t.Context(), parent.ID(), stampAt(2, editor))
+	if err != nil {
+		t.Fatalf("PurgeTask: %v", err)
+	}
+
+	// 휴지통에 있지 않은 하위 Task는 수정된 것으로 보아 버전이 오릅니다.
+	orphaned := mustGetTask(t, repo, live.ID())
+	if orphaned.ParentID() != "" || orphaned.Version() != updatedVersion {
+		t.Fatalf("live subtask = parent %q, version %d, want top-level task at version %d",
+			orphaned.ParentID(), orphaned.Version(), updatedVersion)
+	}
+
+	assertStamp(t, "Updated", orphaned.Updated(), stampAt(2, editor))
+
+	restored, err := repo.RestoreTask(t.Context(), trashed.ID(), stampAt(3, editor))
+	if err != nil {
+		t.Fatalf("RestoreTask: %v", err)
+	}
+
+	if restored.ParentID() != "" || restored.Version() != initialVersion {
+		t.Fatalf("restored subtask = parent %q, version %d, want top-level task at version %d",
+			restored.ParentID(), restored.Version(), initialVersion)
+	}
+
+	assertTask(t, mustGetTask(t, repo, trashed.ID()), "trashed", "", domain.TaskStatusOpen, initialVersion)
+}
+
+func testAuditEntries(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	task := mustCreate(t, repo, "title", "", domain.TaskStatusOpen)
+	renamed := mustUpdate(t, repo,
+		task.SetSpec(mustSpec(t, "renamed", "", domain.TaskStatusOpen)).Touch(stampAt(1, editor)))
+
+	// 저장되지 않은 변경은 기록되지 않습니다.
+	_, err := repo.UpdateTask(t.Context(), task.Touch(stampAt(1, editor)))
+	assertError(t, "UpdateTask with stale version", err, core.ErrConflict)
+
+	query := newAuditQuery(1)
+
+	page := mustListAudit(t, repo, query)
+	if len(page.Entries) != 1 || page.Entries[0].Action() != domain.AuditActionCreate ||
+		len(page.Entries[0].Changes()) == 0 || page.NextCursor != page.Entries[0].ID() {
+		t.Fatalf("first page = %+v, want the create entry with changes and a cursor", page)
+	}
+
+	query.After = page.NextCursor
+
+	page = mustListAudit(t, repo, query)
+	if len(page.Entries) != 1 || page.NextCursor != "" {
+		t.Fatalf("second page = %+v, want the last entry", page)
+	}
+
+	want := domain.NewAuditEntry(domain.AuditActionUpdate, task, renamed, stampAt(1, editor))
+	want = want.WithID(page.Entries[0].ID())
+	assertAuditEntry(t, page.Entries[0], want)
+
+	if got := fmt.Sprint(want.Changes()); got != "[{title title renamed}]" {
+		t.Fatalf("Changes = %s, want the title change", got)
+	}
+}
+
+// testAuditTrash 휴지통으로 옮기고 복원하고 영구 삭제한 기록이 변경과 함께 남는지 확인합니다.
+func testAuditTrash(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	id := mustCreate(t, repo, "title", "", domain.TaskStatusOpen).ID()
+	expired := mustCreate(t, repo, "expired", "", domain.TaskStatusOpen).ID()
+
+	_, err := repo.RestoreTask(t.Context(), id, stampAt(1, editor))
+	assertError(t, "RestoreTask of a live task", err, core.ErrTaskNotFound)
+
+	must := func(name string, err error) {
+		t.Helper()
+
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+
+	must("DeleteTask", repo.DeleteTask(t.Context(), id, stampAt(1, editor)))
+	must("DeleteTask", repo.DeleteTask(t.Context(), expired, stampAt(1, editor)))
+
+	_, err = repo.RestoreTask(t.Context(), id, stampAt(1, editor))
+	must("RestoreTask", err)
+	must("DeleteTask", repo.DeleteTask(t.Context(), id, stampAt(1, editor)))
+	must("PurgeTask", repo.PurgeTask(t.Context(), id, stampAt(1, editor)))
+
+	_, err = repo.PurgeDeletedTasks(t.Context(), stampAt(1, "").At().Add(time.Second), stampAt(1, editor))
+	must("PurgeDeletedTasks", err)
+
+	for task, want := range map[domain.TaskID]string{
+		id:      "[create delete restore delete purge]",
+		expired: "[create delete purge]",
+	} {
+		query := newAuditQuery(0)
+		query.TaskID = task
+
+		entries := mustListAudit(t, repo, query).Entries
+
+		actions := make([]domain.AuditAction, len(entries))
+		for index, entry := range entries {
+			actions[index] = entry.Action()
+		}
+
+		if got := fmt.Sprint(actions); got != want {
+			t.Fatalf("actions of %s = %s, want %s", task, got, want)
+		}
+	}
+}
+
+func testListAuditEntriesFilter(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	mustCreate(t, repo, "first", "", domain.TaskStatusOpen)
+	second := mustCreate(t, repo, "second", "", domain.TaskStatusOpen)
+
+	for offset := 1; offset <= 2; offset++ {
+		title := fmt.Sprintf("second %d", offset)
+		second = mustUpdate(t, repo,
+			second.SetSpec(mustSpec(t, title, "", domain.TaskStatusOpen)).Touch(stampAt(offset, editor)))
+	}
+
+	all := mustListAudit(t, repo, newAuditQuery(0)).Entries
+
+	ids := make([]domain.AuditEntryID, len(all))
+	actions := make([]domain.AuditAction, len(all))
+
+	for index, entry := range all {
+		ids[index], actions[index] = entry.ID(), entry.Action()
+	}
+
+	if got := fmt.Sprint(actions); got != "[create create update update]" {
+		t.Fatalf("actions = %s, want two creates and two updates", got)
+	}
+
+	byTask := newAuditQuery(0)
+	byTask.TaskID = second.ID()
+
+	byActor := newAuditQuery(0)
+	byActor.Actor = creator
+
+	since := stampAt(1, "").At()
+	until := since.Add(time.Second)
+	byTime := newAuditQuery(0)
+	byTime.Since, byTime.Until = &since, &until
+
+	for _, tc := range []struct {
+		name  string
+		query *core.AuditQuery
+		want  []domain.AuditEntryID
+	}{
+		{"task", byTask, ids[1:]},
+		{"actor", byActor, ids[:2]},
+		{"time range", byTime, ids[2:3]},
+	} {
+		page := mustListAudit(t, repo, tc.query)
+
+		got := make([]domain.AuditEntryID, len(page.Entries))
+		for i, entry := range page.Entries {
+			got[i] = entry.ID()
+		}
+
+		if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Fatalf("%s: entries = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func testRevisions(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	tag := mustCreateTag(t, repo, "work", "")
+	tagged := mustTag(t, repo, mustCreate(t, repo, "first", "draft", domain.TaskStatusOpen), tag)
+
+	updated, err := repo.UpdateTask(t.Context(), tagged.SetSpec(mustSpec(t, "second", "final", "")))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	_, err = repo.UpdateTag(t.Context(), tag.SetSpec(mustTagSpec(t, "renamed", "")), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("UpdateTag: %v", err)
+	}
+
+	_, err = repo.UpdateTask(t.Context(), tagged)
+	assertError(t, "UpdateTask with stale version", err, core.ErrConflict)
+
+	// 태그 이름을 바꾸면 태그가 붙은 Task의 새 리비전이 남습니다.
+	renamedVersion := updated.Version() + 1
+
+	revisions := mustListRevisions(t, repo, updated.ID())
+	if len(revisions) != renamedVersion {
+		t.Fatalf("ListRevisions returned %d revisions, want %d", len(revisions), renamedVersion)
+	}
+
+	assertTask(t, revisions[0], "first", "draft", domain.TaskStatusOpen, initialVersion)
+	assertTask(t, revisions[1], "first", "draft", domain.TaskStatusOpen, updatedVersion)
+	assertTask(t, revisions[len(revisions)-1], "second", "final", domain.TaskStatusOpen, renamedVersion)
+
+	revision, err := repo.GetRevision(t.Context(), updated.ID(), updatedVersion)
+	if err != nil {
+		t.Fatalf("GetRevision: %v", err)
+	}
+
+	if tags := revision.Tags(); len(tags) != 1 || tags[0].Name() != "work" {
+		t.Fatalf("revision tags = %v, want the tag as it was when saved", tags)
+	}
+
+	_, err = repo.GetRevision(t.Context(), updated.ID(), renamedVersion+1)
+	assertError(t, "GetRevision of a future version", err, core.ErrRevisionNotFound)
+
+	_, err = repo.GetRevision(t.Context(), "missing", initialVersion)
+	assertError(t, "GetRevision of a missing task", err, core.ErrRevisionNotFound)
+}
+
+func testPurgeRevisions(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	task := mustCreate(t, repo, "task", "", domain.TaskStatusOpen)
+
+	err := repo.DeleteTask(t.Context(), task.ID(), stampAt(1, ""))
+	if err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	revisions, err := repo.ListRevisions(t.Context(), task.ID())
+	if err != nil || len(revisions) != 1 {
+		t.Fatalf("ListRevisions in trash = %v, %v; want the revision kept", revisions, err)
+	}
+
+	err = repo.PurgeTask(t.Context(), task.ID(), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("PurgeTask: %v", err)
+	}
+
+	revisions, err = repo.ListRevisions(t.Context(), task.ID())
+	if err != nil || len(revisions) != 0 {
+		t.Fatalf("ListRevisions after purge = %v, %v; want none", revisions, err)
+	}
+}
+
+func testComments(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	task := mustCreate(t, repo, "task", "", domain.TaskStatusOpen)
+	other := mustCreate(t, repo, "other", "", domain.TaskStatusOpen)
+	comment := mustCreateComment(t, repo, task.ID(), "", "body")
+	otherComment := mustCreateComment(t, repo, other.ID(), "", "other")
+
+	_, err := repo.CreateComment(t.Context(), "missing", "", mustCommentSpec(t, "body"), stampAt(0, creator))
+	assertError(t, "CreateComment on missing task", err, core.ErrTaskNotFound)
+
+	_, err = repo.CreateComment(t.Context(), task.ID(), otherComment.ID(), mustCommentSpec(t, "body"), stampAt(0, creator))
+	assertError(t, "CreateComment replying to another task's comment", err, core.ErrCommentNotFound)
+
+	edited := comment.SetSpec(mustCommentSpec(t, "edited")).Touch(stampAt(1, editor))
+
+	updated, err := repo.UpdateComment(t.Context(), edited)
+	if err != nil {
+		t.Fatalf("UpdateComment: %v", err)
+	}
+
+	stored, err := repo.GetComment(t.Context(), comment.ID())
+	if err != nil {
+		t.Fatalf("GetComment: %v", err)
+	}
+
+	for _, got := range []*domain.Comment{updated, stored} {
+		if got.Body() != "edited" || got.TaskID() != task.ID() || !got.IsEdited() {
+			t.Errorf("updated comment = %+v, want edited body on the same task", got)
+		}
+
+		assertStamp(t, "Created", got.Created(), stampAt(0, creator))
+		assertStamp(t, "Updated", got.Updated(), stampAt(1, editor))
+	}
+
+	_, err = repo.GetComment(t.Context(), "missing")
+	assertError(t, "GetComment", err, core.ErrCommentNotFound)
+
+	_, err = repo.UpdateComment(t.Context(), domain.NewComment(domain.CommentParams{ID: "missing"})) //nolint:exhaustruct
+	assertError(t, "UpdateComment", err, core.ErrCommentNotFound)
+
+	err = repo.DeleteComment(t.Context(), "missing")
+	assertError(t, "DeleteComment", err, core.ErrCommentNotFound)
+}
+
+func testDeleteCommentReplies(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	task := mustCreate(t, repo, "task", "", domain.TaskStatusOpen)
+	first := mustCreateComment(t, repo, task.ID(), "", "first")
+	reply := mustCreateComment(t, repo, task.ID(), first.ID(), "reply")
+	mustCreateComment(t, repo, task.ID(), reply.ID(), "nested")
+	second := mustCreateComment(t, repo, task.ID(), "", "second")
+
+	comments := mustListComments(t, repo, task.ID())
+	if len(comments) != 4 || comments[1].ParentID() != first.ID() {
+		t.Fatalf("ListComments = %+v, want 4 comments in creation order", comments)
+	}
+
+	err := repo.DeleteComment(t.Context(), first.ID())
+	if err != nil {
+		t.Fatalf("DeleteComment: %v", err)
+	}
+
+	comments = mustListComments(t, repo, task.ID())
+	if len(comments) != 1 || comments[0].ID() != second.ID() {
+		t.Fatalf("ListComments after delete = %+v, want only %s", comments, second.ID())
+	}
+}
+
+func testPurgeComments(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	task := mustCreate(t, repo, "task", "", domain.TaskStatusOpen)
+	mustCreateComment(t, repo, task.ID(), "", "body")
+
+	err := repo.DeleteTask(t.Context(), task.ID(), stampAt(1, ""))
+	if err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	_, err = repo.CreateComment(t.Context(), task.ID(), "", mustCommentSpec(t, "body"), stampAt(0, creator))
+	assertError(t, "CreateComment on task in trash", err, core.ErrTaskNotFound)
+
+	if comments := mustListComments(t, repo, task.ID()); len(comments) != 1 {
+		t.Fatalf("ListComments in trash = %+v, want the comment kept", comments)
+	}
+
+	err = repo.PurgeTask(t.Context(), task.ID(), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("PurgeTask: %v", err)
+	}
+
+	if comments := mustListComments(t, repo, task.ID()); len(comments) != 0 {
+		t.Fatalf("ListComments after purge = %+v, want none", comments)
+	}
+}
+
+func testAttachments(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	task := mustCreate(t, repo, "task", "", domain.TaskStatusOpen)
+	other := mustCreate(t, repo, "other", "", domain.TaskStatusOpen)
+	first := mustCreateAttachment(t, repo, task.ID(), "first.png")
+	mustCreateAttachment(t, repo, other.ID(), "other.txt")
+	second := mustCreateAttachment(t, repo, task.ID(), "second.txt")
+
+	_, err := repo.CreateAttachment(t.Context(), "missing", mustAttachmentSpec(t, "file.txt"),
+		testAttachmentBlob("missing"), stampAt(0, creator))
+	assertError(t, "CreateAttachment on missing task", err, core.ErrTaskNotFound)
+
+	stored, err := repo.GetAttachment(t.Context(), first.ID())
+	if err != nil {
+		t.Fatalf("GetAttachment: %v", err)
+	}
+
+	if stored.Name() != "first.png" || stored.TaskID() != task.ID() || stored.Blob() != testAttachmentBlob("first.png") {
+		t.Errorf("GetAttachment = %+v, want first.png on %s", stored, task.ID())
+	}
+
+	assertStamp(t, "Created", stored.Created(), stampAt(0, creator))
+
+	attachments := mustListAttachments(t, repo, task.ID())
+	if len(attachments) != 2 || attachments[0].ID() != first.ID() || attachments[1].ID() != second.ID() {
+		t.Fatalf("ListAttachments = %+v, want [%s %s]", attachments, first.ID(), second.ID())
+	}
+
+	err = repo.DeleteAttachment(t.Context(), first.ID())
+	if err != nil {
+		t.Fatalf("DeleteAttachment: %v", err)
+	}
+
+	_, err = repo.GetAttachment(t.Context(), first.ID())
+	assertError(t, "GetAttachment after delete", err, core.ErrAttachmentNotFound)
+
+	err = repo.DeleteAttachment(t.Context(), first.ID())
+	assertError(t, "DeleteAttachment", err, core.ErrAttachmentNotFound)
+}
+
+// testAttachmentsInTrash 첨부 파일은 blob과 함께 지워야 하므로 영구 삭제한 뒤에도 남아 있어야 하며,
+// ListPurgedAttachments로 찾을 수 있어야 합니다.
+func testAttachmentsInTrash(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	task := mustCreate(t, repo, "task", "", domain.TaskStatusOpen)
+	attachment := mustCreateAttachment(t, repo, task.ID(), "file.txt")
+	second := mustCreateAttachment(t, repo, task.ID(), "second.txt")
+	live := mustCreate(t, repo, "live", "", domain.TaskStatusOpen)
+	mustCreateAttachment(t, repo, live.ID(), "live.txt")
+
+	err := repo.DeleteTask(t.Context(), task.ID(), stampAt(1, ""))
+	if err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	assertPurgedAttachments(t, repo, 10)
+
+	_, err = repo.CreateAttachment(t.Context(), task.ID(), mustAttachmentSpec(t, "file.txt"),
+		testAttachmentBlob("file.txt"), stampAt(0, creator))
+	assertError(t, "CreateAttachment on task in trash", err, core.ErrTaskNotFound)
+
+	err = repo.PurgeTask(t.Context(), task.ID(), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("PurgeTask: %v", err)
+	}
+
+	attachments := mustListAttachments(t, repo, task.ID())
+	if len(attachments) != 2 || attachments[0].ID() != attachment.ID() {
+		t.Fatalf("ListAttachments after purge = %+v, want %s and %s kept", attachments, attachment.ID(), second.ID())
+	}
+
+	assertPurgedAttachments(t, repo, 1, attachment.ID())
+	assertPurgedAttachments(t, repo, 10, attachment.ID(), second.ID())
+
+	err = repo.DeleteAttachment(t.Context(), attachment.ID())
+	if err != nil {
+		t.Fatalf("DeleteAttachment: %v", err)
+	}
+
+	assertPurgedAttachments(t, repo, 10, second.ID())
+}
+
+// assertPurgedAttachments ListPurgedAttachments가 limit개까지 want를 순서대로 반환하는지 확인합니다.
+func assertPurgedAttachments(t *testing.T, repo core.Repository, limit int, want ...domain.AttachmentID) {
+	t.Helper()
+
+	attachments, err := repo.ListPurgedAttachments(t.Context(), limit)
+	if err != nil {
+		t.Fatalf("ListPurgedAttachments: %v", err)
+	}
+
+	got := make([]domain.AttachmentID, len(attachments))
+	for i, attachment := range attachments {
+		got[i] = attachment.ID()
+	}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("ListPurgedAttachments(%d) = %v, want %v", limit, got, want)
+	}
+}
+
+func testProjects(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	ops := mustCreateProject(t, repo, "OPS", "운영")
+	mustCreateProject(t, repo, "DEV", "개발")
+
+	_, err := repo.CreateProject(t.Context(), "OPS", mustProjectSpec(t, "다른 운영"), stampAt(0, creator))
+	assertError(t, "CreateProject with duplicate key", err, core.ErrDuplicateProject)
+
+	projects, err := repo.ListProjects(t.Context())
+	if err != nil {
+		t.Fatalf("ListProjects: %v", err)
+	}
+
+	if got := projectKeys(projects); got != "[DEV OPS]" {
+		t.Fatalf("ListProjects = %s, want [DEV OPS]", got)
+	}
+
+	spec, err := domain.NewProjectSpec(domain.ProjectSpecParams{
+		Name:            "운영팀",
+		Description:     "설명",
+		DefaultPriority: domain.TaskPriorityUrgent,
+	})
+	if err != nil {
+		t.Fatalf("NewProjectSpec: %v", err)
+	}
+
+	_, err = repo.UpdateProject(t.Context(), ops.SetSpec(spec, stampAt(1, editor)))
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+
+	stored, err := repo.GetProject(t.Context(), ops.ID())
+	if err != nil {
+		t.Fatalf("GetProject: %v", err)
+	}
+
+	if stored.Key() != "OPS" || stored.Name() != "운영팀" || stored.DefaultPriority() != domain.TaskPriorityUrgent {
+		t.Fatalf("GetProject = %s %s %s, want OPS 운영팀 urgent", stored.Key(), stored.Name(), stored.DefaultPriority())
+	}
+
+	if ops.Version() != initialVersion || stored.Version() != updatedVersion {
+		t.Fatalf("project versions = %d, %d, want %d, %d", ops.Version(), stored.Version(), initialVersion, updatedVersion)
+	}
+
+	assertStamp(t, "Created", stored.Created(), stampAt(0, creator))
+	assertStamp(t, "Updated", stored.Updated(), stampAt(1, editor))
+
+	// 오래된 버전으로 보드 열을 바꾸면 먼저 저장된 이름을 덮어쓰지 않습니다.
+	columns := mustBoardColumns(t,
+		domain.NewBoardColumn("all", "전체", []domain.TaskStatus{domain.TaskStatusOpen}))
+
+	_, err = repo.UpdateProject(t.Context(), ops.SetColumns(columns, stampAt(2, editor)))
+	assertError(t, "UpdateProject with stale version", err, core.ErrProjectConflict)
+
+	if stale, err := repo.GetProject(t.Context(), ops.ID()); err != nil || stale.Name() != "운영팀" ||
+		stale.Version() != updatedVersion {
+		t.Fatalf("GetProject after conflict = %+v, %v, want the first update", stale, err)
+	}
+
+	err = repo.DeleteProject(t.Context(), ops.ID())
+	if err != nil {
+		t.Fatalf("DeleteProject: %v", err)
+	}
+
+	_, err = repo.GetProject(t.Context(), ops.ID())
+	assertError(t, "GetProject after DeleteProject", err, core.ErrProjectNotFound)
+
+	_, err = repo.UpdateProject(t.Context(), ops)
+	assertError(t, "UpdateProject after DeleteProject", err, core.ErrProjectNotFound)
+
+	err = repo.DeleteProject(t.Context(), ops.ID())
+	assertError(t, "DeleteProject after DeleteProject", err, core.ErrProjectNotFound)
+}
+
+func testProjectTasks(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	ops := mustCreateProject(t, repo, "OPS", "운영")
+	dev := mustCreateProject(t, repo, "DEV", "개발")
+
+	first := mustCreateInProject(t, repo, ops.ID())
+	other := mustCreateInProject(t, repo, dev.ID())
+	second := mustCreateInProject(t, repo, ops.ID())
+	unassigned := mustCreate(t, repo, "unassigned", "", domain.TaskStatusOpen)
+
+	keys := []string{first.Key(), other.Key(), second.Key(), unassigned.Key()}
+	if got := fmt.Sprintf("%q", keys); got != `["OPS-1" "DEV-1" "OPS-2" ""]` {
+		t.Fatalf("keys = %s, want OPS-1, DEV-1, OPS-2 and none", got)
+	}
+
+	_, err := repo.CreateTask(t.Context(), mustSpecWith(t, domain.TaskSpecParams{
+		Title:       "title",
+		Description: "",
+		Status:      domain.TaskStatusOpen,
+		Priority:    "",
+		DueDate:     nil,
+		ParentID:    "",
+		ProjectID:   "missing",
+	}), stampAt(0, creator))
+	assertError(t, "CreateTask with missing project", err, core.ErrProjectNotFound)
+
+	query := newQuery("", pageSize)
+	query.Filter.ProjectID = ops.ID()
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{first.ID(), second.ID()})
+
+	query = newQuery("", pageSize)
+	query.Filter.Key = "OPS-2"
+	assertIDs(t, mustList(t, repo, query).Tasks, []domain.TaskID{second.ID()})
+
+	// 휴지통에 있는 Task도 프로젝트에 속한 것으로 봅니다.
+	for _, task := range []*domain.Task{other, second} {
+		err = repo.DeleteTask(t.Context(), task.ID(), stampAt(1, editor))
+		if err != nil {
+			t.Fatalf("DeleteTask: %v", err)
+		}
+	}
+
+	err = repo.DeleteProject(t.Context(), dev.ID())
+	assertError(t, "DeleteProject with a task in the trash", err, core.ErrProjectNotEmpty)
+
+	// 영구 삭제된 Task의 번호는 다시 쓰이지 않습니다.
+	err = repo.PurgeTask(t.Context(), second.ID(), stampAt(1, editor))
+	if err != nil {
+		t.Fatalf("PurgeTask: %v", err)
+	}
+
+	if third := mustCreateInProject(t, repo, ops.ID()); third.Key() != "OPS-3" {
+		t.Fatalf("Key after PurgeTask = %q, want OPS-3", third.Key())
+	}
+
+	revision, err := repo.GetRevision(t.Context(), first.ID(), initialVersion)
+	if err != nil || revision.ProjectID() != ops.ID() || revision.Key() != "OPS-1" {
+		t.Fatalf("GetRevision = %v, %v, want a revision of OPS-1", revision, err)
+	}
+}
+
+func testBoard(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	ops := mustCreateProject(t, repo, "OPS", "운영")
+	columns := mustBoardColumns(t,
+		domain.NewBoardColumn("todo", "할 일", []domain.TaskStatus{domain.TaskStatusOpen}),
+		domain.NewBoardColumn("doing", "진행 중", []domain.TaskStatus{domain.TaskStatusInProgress}),
+	)
+
+	_, err := repo.UpdateProject(t.Context(), ops.SetColumns(columns, stampAt(1, editor)))
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+
+	stored, err := repo.GetProject(t.Context(), ops.ID())
+	if err != nil || fmt.Sprint(stored.Columns()) != fmt.Sprint(columns) {
+		t.Fatalf("GetProject = %v, %v, want columns %v", stored, err, columns)
+	}
+
+	task := mustCreateInProject(t, repo, ops.ID())
+	doing, _ := stored.Column("doing")
+
+	_, err = repo.UpdateTask(t.Context(), mustMoveOnBoard(t, task, doing, "M").Touch(stampAt(1, editor)))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	moved, err := repo.GetTask(t.Context(), task.ID())
+	if err != nil || moved.Rank() != "M" || moved.Status() != domain.TaskStatusInProgress {
+		t.Fatalf("GetTask = %v, %v, want rank M in in_progress", moved, err)
+	}
+
+	revision, err := repo.GetRevision(t.Context(), task.ID(), moved.Version())
+	if err != nil || revision.Rank() != "M" {
+		t.Fatalf("GetRevision = %v, %v, want rank M", revision, err)
+	}
+}
+
+func testCanceledContext(t *testing.T, repo core.Repository) {
+	t.Helper()
+
+	created := mustCreate(t, repo, "title", "", domain.TaskStatusOpen)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := repo.CreateTask(ctx, mustSpec(t, "title", "", domain.TaskStatusOpen), stampAt(0, creator))
+	assertError(t, "CreateTask", err, context.Canceled)
+
+	_, err = repo.GetTask(ctx, created.ID())
+	assertError(t, "GetTask", err, context.Canceled)
+
+	_, err = repo.ListTasks(ctx, newQuery("", 0))
+	assertError(t, "ListTasks", err, context.Canceled)
+
+	_, err = repo.UpdateTask(ctx, created)
+	assertError(t, "UpdateTask", err, context.Canceled)
+
+	err = repo.DeleteTask(ctx, created.ID(), stampAt(1, ""))
+	assertError(t, "DeleteTask", err, context.Canceled)
+}
+
+func newQuery(sort string, limit int) *core.ListTasksQuery {
+	taskSort, err := core.ParseTaskSort(sort)
+	if err != nil {
+		panic(err)
+	}
+
+	query := &core.ListTasksQuery{
+		Filter: core.TaskFilter{
+			IDs:        nil,
+			ParentID:   "",
+			ProjectID:  "",
+			Key:        "",
+			Statuses:   nil,
+			Priorities: nil,
+			Tags:       nil,
+			TagMatch:   core.TagMatchAny,
+			Search:     "",
+			DueBefore:  nil,
+			DueAfter:   nil,
+			OverdueAt:  nil,
+			Deleted:    false,
+		},
+		Sort:   taskSort,
+		Limit:  limit,
+		Cursor: nil,
+	}
+
+	err = query.Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	return query
+}
+
+func mustSpec(t *testing.T, title, description string, status domain.TaskStatus) *domain.TaskSpec {
+	t.Helper()
+
+	return mustSpecWith(t, domain.TaskSpecParams{
+		Title:       title,
+		Description: description,
+		Status:      status,
+		Priority:    "",
+		DueDate:     nil,
+		ParentID:    "",
+		ProjectID:   "",
+	})
+}
+
+func mustSpecWith(t *testing.T, params domain.TaskSpecParams) *domain.TaskSpec {
+	t.Helper()
+
+	spec, err := domain.NewTaskSpec(params)
+	if err != nil {
+		t.Fatalf("NewTaskSpec: %v", err)
+	}
+
+	return spec
+}
+
+func mustCreate(t *testing.T, repo core.Repository, title, description string, status domain.TaskStatus) *domain.Task {
+	t.Helper()
+
+	task, err := repo.CreateTask(t.Context(), mustSpec(t, title, description, status), stampAt(0, creator))
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	return task
+}
+
+func mustCreateSubtask(t *testing.T, repo core.Repository, title string, parentID domain.TaskID) *domain.Task {
+	t.Helper()
+
+	task, err := repo.CreateTask(t.Context(), mustSpecWith(t, domain.TaskSpecParams{
+		Title:       title,
+		Description: "",
+		Status:      domain.TaskStatusOpen,
+		Priority:    "",
+		DueDate:     nil,
+		ParentID:    parentID,
+		ProjectID:   "",
+	}), stampAt(0, creator))
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	return task
+}
+
+func mustCreatePlanned(
+	t *testing.T,
+	repo core.Repository,
+	status domain.TaskStatus,
+	priority domain.TaskPriority,
+	dueDate *time.Time,
+) *domain.Task {
+	t.Helper()
+
+	spec := mustSpecWith(t, domain.TaskSpecParams{
+		Title:       "title",
+		Description: "",
+		Status:      status,
+		Priority:    priority,
+		DueDate:     dueDate,
+		ParentID:    "",
+		ProjectID:   "",
+	})
+
+	task, err := repo.CreateTask(t.Context(), spec, stampAt(0, creator))
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	return task
+}
+
+func mustTagSpec(t *testing.T, name, color string) *domain.TagSpec {
+	t.Helper()
+
+	spec, err := domain.NewTagSpec(name, color)
+	if err != nil {
+		t.Fatalf("NewTagSpec: %v", err)
+	}
+
+	return spec
+}
+
+func mustCreateTag(t *testing.T, repo core.Repository, name, color string) *domain.Tag {
+	t.Helper()
+
+	tag, err := repo.CreateTag(t.Context(), mustTagSpec(t, name, color))
+	if err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+
+	return tag
+}
+
+func mustProjectSpec(t *testing.T, name string) *domain.ProjectSpec {
+	t.Helper()
+
+	spec, err := domain.NewProjectSpec(domain.ProjectSpecParams{Name: name, Description: "", DefaultPriority: ""})
+	if err != nil {
+		t.Fatalf("NewProjectSpec: %v", err)
+	}
+
+	return spec
+}
+
+func mustCreateProject(t *testing.T, repo core.Repository, key domain.ProjectKey, name string) *domain.Project {
+	t.Helper()
+
+	project, err := repo.CreateProject(t.Context(), key, mustProjectSpec(t, name), stampAt(0, creator))
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+
+	return project
+}
+
+func mustBoardColumns(t *testing.T, columns ...domain.BoardColumn) []domain.BoardColumn {
+	t.Helper()
+
+	ret, err := domain.NewBoardColumns(columns)
+	if err != nil {
+		t.Fatalf("NewBoardColumns: %v", err)
+	}
+
+	return ret
+}
+
+func mustMoveOnBoard(t *testing.T, task *domain.Task, column domain.BoardColumn, rank string) *domain.Task {
+	t.Helper()
+
+	moved, err := task.MoveOnBoard(column, rank)
+	if err != nil {
+		t.Fatalf("MoveOnBoard: %v", err)
+	}
+
+	return moved
+}
+
+func mustCreateInProject(t *testing.T, repo core.Repository, projectID domain.ProjectID) *domain.Task {
+	t.Helper()
+
+	task, err := repo.CreateTask(t.Context(), mustSpecWith(t, domain.TaskSpecParams{
+		Title:       "title",
+		Description: "",
+		Status:      domain.TaskStatusOpen,
+		Priority:    "",
+		DueDate:     nil,
+		ParentID:    "",
+		ProjectID:   projectID,
+	}), stampAt(0, creator))
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	return task
+}
+
+// mustTag task에 태그를 붙여 저장합니다.
+func mustTag(t *testing.T, repo core.Repository, task *domain.Task, tags ...*domain.Tag) *domain.Task {
+	t.Helper()
+
+	for _, tag := range tags {
+		task = task.AddTag(tag)
+	}
+
+	updated, err := repo.UpdateTask(t.Context(), task)
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	return updated
+}
+
+func newAuditQuery(limit int) *core.AuditQuery {
+	query := &core.AuditQuery{
+		TaskID: "",
+		Actor:  "",
+		Since:  nil,
+		Until:  nil,
+		Limit:  limit,
+		After:  "",
+	}
+
+	err := query.Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	return query
+}
+
+func mustGetTask(t *testing.T, repo core.Repository, id domain.TaskID) *domain.Task {
+	t.Helper()
+
+	task, err := repo.GetTask(t.Context(), id)
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+
+	return task
+}
+
+func mustUpdate(t *testing.T, repo core.Repository, task *domain.Task) *domain.Task {
+	t.Helper()
+
+	updated, err := repo.UpdateTask(t.Context(), task)
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	return updated
+}
+
+func mustListAudit(t *testing.T, repo core.Repository, query *core.AuditQuery) *core.AuditPage {
+	t.Helper()
+
+	page, err := repo.ListAuditEntries(t.Context(), query)
+	if err != nil {
+		t.Fatalf("ListAuditEntries: %v", err)
+	}
+
+	return page
+}
+
+// assertAuditEntry 저장소마다 시각의 위치 정보가 다를 수 있으므로 시각은 Equal로 비교합니다.
+func assertAuditEntry(t *testing.T, got, want domain.AuditEntry) {
+	t.Helper()
+
+	if !got.Stamp().At().Equal(want.Stamp().At()) {
+		t.Fatalf("audit entry At = %v, want %v", got.Stamp().At(), want.Stamp().At())
+	}
+
+	if got.ID() != want.ID() || got.TaskID() != want.TaskID() || got.Action() != want.Action() ||
+		got.Stamp().By() != want.Stamp().By() || fmt.Sprint(got.Changes()) != fmt.Sprint(want.Changes()) {
+		t.Fatalf("audit entry = %+v, want %+v", got, want)
+	}
+}
+
+func mustCommentSpec(t *testing.T, body string) *domain.CommentSpec {
+	t.Helper()
+
+	spec, err := domain.NewCommentSpec(body)
+	if err != nil {
+		t.Fatalf("NewCommentSpec: %v", err)
+	}
+
+	return spec
+}
+
+func mustCreateComment(
+	t *testing.T,
+	repo core.Repository,
+	taskID domain.TaskID,
+	parentID domain.CommentID,
+	body string,
+) *domain.Comment {
+	t.Helper()
+
+	comment, err := repo.CreateComment(t.Context(), taskID, parentID, mustCommentSpec(t, body), stampAt(0, creator))
+	if err != nil {
+		t.Fatalf("CreateComment: %v", err)
+	}
+
+	return comment
+}
+
+func mustListRevisions(t *testing.T, repo core.Repository, id domain.TaskID) []*domain.Task {
+	t.Helper()
+
+	revisions, err := repo.ListRevisions(t.Context(), id)
+	if err != nil {
+		t.Fatalf("ListRevisions: %v", err)
+	}
+
+	return revisions
+}
+
+func mustListComments(t *testing.T, repo core.Repository, taskID domain.TaskID) []*domain.Comment {
+	t.Helper()
+
+	comments, err := repo.ListComments(t.Context(), taskID)
+	if err != nil {
+		t.Fatalf("ListComments: %v", err)
+	}
+
+	return comments
+}
+
+func mustAttachmentSpec(t *testing.T, name string) *domain.AttachmentSpec {
+	t.Helper()
+
+	spec, err := domain.NewAttachmentSpec(name)
+	if err != nil {
+		t.Fatalf("NewAttachmentSpec: %v", err)
+	}
+
+	return spec
+}
+
+// testAttachmentBlob 파일 이름으로 구분되는 임의의 blob 정보입니다.
+func testAttachmentBlob(name string) domain.AttachmentBlob {
+	return domain.AttachmentBlob{
+		Key:         "blobs/" + name,
+		ContentType: "text/plain; charset=utf-8",
+		Size:        int64(len(name)),
+		Checksum:    "checksum-" + name,
+	}
+}
+
+func mustCreateAttachment(t *testing.T, repo core.Repository, taskID domain.TaskID, name string) *domain.Attachment {
+	t.Helper()
+
+	attachment, err := repo.CreateAttachment(t.Context(), taskID, mustAttachmentSpec(t, name),
+		testAttachmentBlob(name), stampAt(0, creator))
+	if err != nil {
+		t.Fatalf("CreateAttachment: %v", err)
+	}
+
+	return attachment
+}
+
+func mustListAttachments(t *testing.T, repo core.Repository, taskID domain.TaskID) []*domain.Attachment {
+	t.Helper()
+
+	attachments, err := repo.ListAttachments(t.Context(), taskID)
+	if err != nil {
+		t.Fatalf("ListAttachments: %v", err)
+	}
+
+	return attachments
+}
+
+func mustList(t *testing.T, repo core.Repository, query *core.ListTasksQuery) *core.TaskPage {
+	t.Helper()
+
+	page, err := repo.ListTasks(t.Context(), query)
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+
+	return page
+}
+
+func assertTask(t *testing.T, task *domain.Task, title, description string, status domain.TaskStatus, version int) {
+	t.Helper()
+
+	if task.Title() != title {
+		t.Errorf("Title = %q, want %q", task.Title(), title)
+	}
+
+	if task.Description() != description {
+		t.Errorf("Description = %q, want %q", task.Description(), description)
+	}
+
+	if task.Status() != status {
+		t.Errorf("Status = %q, want %q", task.Status(), status)
+	}
+
+	if task.Version() != version {
+		t.Errorf("Version = %d, want %d", task.Version(), version)
+	}
+}
+
+// stampAt 기준 시각에서 offset초 뒤의 Stamp입니다. 모든 저장소가 정확히 보존할 수 있도록 초 단위를 사용합니다.
+func stampAt(offset int, by string) domain.Stamp {
+	base := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	return domain.NewStamp(base.Add(time.Duration(offset)*time.Second), by)
+}
+
+func seconds(d time.Duration) int {
+	return int(d / time.Second)
+}
+
+func equalTime(got, want *time.Time) bool {
+	if got == nil || want == nil {
+		return got == want
+	}
+
+	return got.Equal(*want)
+}
+
+func assertStamp(t *testing.T, name string, got, want domain.Stamp) {
+	t.Helper()
+
+	if !got.At().Equal(want.At()) || got.By() != want.By() {
+		t.Errorf("%s = %v by %q, want %v by %q", name, got.At(), got.By(), want.At(), want.By())
+	}
+}
+
+func assertIDs(t *testing.T, tasks []*domain.Task, want []domain.TaskID) {
+	t.Helper()
+
+	got := make([]domain.TaskID, len(tasks))
+	for i, task := range tasks {
+		got[i] = task.ID()
+	}
+
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("task IDs = %v, want %v", got, want)
+	}
+}
+
+func assertDependencies(t *testing.T, repo core.Repository, ids []domain.TaskID, want []domain.Dependency) {
+	t.Helper()
+
+	got, err := repo.ListDependencies(t.Context(), ids)
+	if err != nil {
+		t.Fatalf("ListDependencies: %v", err)
+	}
+
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("ListDependencies = %v, want %v", got, want)
+	}
+}
+
+func tagNames(tags []*domain.Tag) string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name()
+	}
+
+	return fmt.Sprint(names)
+}
+
+func projectKeys(projects []*domain.Project) string {
+	keys := make([]domain.ProjectKey, len(projects))
+	for i, project := range projects {
+		keys[i] = project.Key()
+	}
+
+	return fmt.Sprint(keys)
+}
+
+func assertError(t *testing.T, op string, err, want error) {
+	t.Helper()
+
+	if !errors.Is(err, want) {
+		t.Fatalf("%s error = %v, want %v", op, err, want)
+	}
+}
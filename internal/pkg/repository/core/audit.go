@@ -0,0 +1,75 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+// AuditQuery 감사 기록 조회 조건입니다. 기록은 항상 ID(기록된 순서) 오름차순으로 반환됩니다.
+type AuditQuery struct {
+	// TaskID 비어 있지 않으면 이 Task의 기록만 조회합니다.
+	TaskID domain.TaskID
+	// Actor 비어 있지 않으면 이 주체가 남긴 기록만 조회합니다.
+	Actor string
+	// Since 기록 시각이 이 시각과 같거나 늦은 기록만 조회합니다.
+	Since *time.Time
+	// Until 기록 시각이 이 시각보다 이른 기록만 조회합니다.
+	Until *time.Time
+	Limit int
+	// After 비어 있지 않으면 이 ID 다음의 기록부터 조회합니다. 이전 페이지의 NextCursor입니다.
+	// 조회한 뒤에 커밋된 기록은 이 ID보다 작을 수 있으며, 그러면 이후 페이지에 나타나지 않습니다.
+	After domain.AuditEntryID
+}
+
+// Validate 쿼리 값을 검사합니다. Limit이 0이면 DefaultListLimit이 적용됩니다.
+func (q *AuditQuery) Validate() error {
+	if q.Limit == 0 {
+		q.Limit = DefaultListLimit
+	}
+
+	if q.Limit < 0 || q.Limit > MaxListLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidQuery, MaxListLimit)
+	}
+
+	if q.Since != nil && q.Until != nil && !q.Since.Before(*q.Until) {
+		return fmt.Errorf("%w: since must be earlier than until", ErrInvalidQuery)
+	}
+
+	return nil
+}
+
+// Match 기록이 ID 커서를 제외한 조회 조건을 만족하는지 확인합니다.
+func (q *AuditQuery) Match(entry *domain.AuditEntry) bool {
+	if q.TaskID != "" && entry.TaskID() != q.TaskID {
+		return false
+	}
+
+	if q.Actor != "" && entry.Stamp().By() != q.Actor {
+		return false
+	}
+
+	if q.Since != nil && entry.Stamp().At().Before(*q.Since) {
+		return false
+	}
+
+	return q.Until == nil || entry.Stamp().At().Before(*q.Until)
+}
+
+type AuditPage struct {
+	Entries []domain.AuditEntry
+	// NextCursor 다음 페이지가 없으면 빈 문자열입니다.
+	NextCursor domain.AuditEntryID
+}
+
+// NewAuditPage 한 건 더 읽은 entries로 limit 크기의 페이지를 만듭니다.
+func NewAuditPage(entries []domain.AuditEntry, limit int) *AuditPage {
+	page := &AuditPage{Entries: entries, NextCursor: ""}
+	if len(entries) > limit {
+		page.Entries = entries[:limit]
+		page.NextCursor = page.Entries[limit-1].ID()
+	}
+
+	return page
+}
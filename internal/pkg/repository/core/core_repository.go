@@ -1,11 +1,154 @@
 package core
 
-import "github.com/neatflowcv/tasker/internal/pkg/domain"
+import (
+	"context"
+	"time"
 
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+// Repository tasker의 모든 데이터를 다루는 저장소입니다.
 type Repository interface {
-	CreateTask(spec *domain.TaskSpec) (*domain.Task, error)
-	ListTasks() ([]*domain.Task, error)
-	GetTask(id domain.TaskID) (*domain.Task, error)
-	UpdateTask(task *domain.Task) (*domain.Task, error)
-	DeleteTask(id domain.TaskID) error
+	TaskRepository
+	TagRepository
+	DependencyRepository
+	TrashRepository
+	AuditRepository
+	RevisionRepository
+	CommentRepository
+	AttachmentRepository
+	ProjectRepository
+}
+
+// TaskRepository Task 저장소입니다.
+// CreateTask는 stamp를 생성 정보와 수정 정보로 함께 저장합니다. spec에 프로젝트가 지정되면 프로젝트에서 다음 번호를
+// 원자적으로 배정하여 "키-번호" 형식의 Task 키를 만들며, 프로젝트가 없으면 ErrProjectNotFound를 반환합니다.
+// 번호는 Task를 영구 삭제해도 다시 쓰이지 않습니다.
+// UpdateTask는 저장된 버전이 task.Version()과 다르면 ErrConflict를 반환하고, 성공하면 버전을 1 증가시킵니다.
+// task의 태그도 함께 저장하며, 존재하지 않는 태그가 있으면 ErrTagNotFound를 반환합니다.
+// DeleteTask는 Task를 stamp.At() 시각에 휴지통으로 옮깁니다. 휴지통에 있는 Task는 ListTasks에서 Filter.Deleted로만 조회되며,
+// 그 밖의 메서드에서는 없는 Task로 취급됩니다. 태그와 의존성, 댓글, 첨부 파일은 복원할 수 있도록 영구 삭제할 때까지 유지됩니다.
+// CreateTask, UpdateTask, DeleteTask는 변경과 같은 트랜잭션에서 감사 기록을 남깁니다.
+type TaskRepository interface {
+	CreateTask(ctx context.Context, spec *domain.TaskSpec, stamp domain.Stamp) (*domain.Task, error)
+	ListTasks(ctx context.Context, query *ListTasksQuery) (*TaskPage, error)
+	GetTask(ctx context.Context, id domain.TaskID) (*domain.Task, error)
+	UpdateTask(ctx context.Context, task *domain.Task) (*domain.Task, error)
+	DeleteTask(ctx context.Context, id domain.TaskID, stamp domain.Stamp) error
+}
+
+// TrashRepository 휴지통에 있는 Task를 복원하거나 영구 삭제합니다.
+// 휴지통에 없는 Task를 지정하면 ErrTaskNotFound를 반환합니다. 복원해도 Task의 버전은 바뀌지 않습니다.
+// 영구 삭제하면 Task의 태그 연결과 댓글, Task가 양 끝 중 하나인 의존성도 함께 삭제하고, 하위 Task는 최상위 Task로
+// 만듭니다. 휴지통에 있지 않은 하위 Task는 수정된 것으로 보아 stamp로 버전을 올립니다.
+// 첨부 파일은 blob 저장소의 본문과 함께 지워야 하므로 남겨 두며, 호출자가 ListPurgedAttachments로 찾아
+// DeleteAttachment로 삭제해야 합니다.
+// 복원과 영구 삭제는 stamp로 같은 트랜잭션에서 감사 기록을 남깁니다.
+type TrashRepository interface {
+	RestoreTask(ctx context.Context, id domain.TaskID, stamp domain.Stamp) (*domain.Task, error)
+	PurgeTask(ctx context.Context, id domain.TaskID, stamp domain.Stamp) error
+	// PurgeDeletedTasks before보다 먼저 삭제된 Task를 모두 영구 삭제하고 그 ID를 ID 순서로 반환합니다.
+	PurgeDeletedTasks(ctx context.Context, before time.Time, stamp domain.Stamp) ([]domain.TaskID, error)
+}
+
+// TagRepository 태그 저장소입니다. 태그 이름은 유일하며, 중복되면 ErrDuplicateTag를 반환합니다.
+// UpdateTag와 DeleteTag는 태그가 붙은 Task도 함께 바꿉니다. 휴지통에 있지 않은 Task는 stamp로 수정한 것으로 보아
+// 같은 트랜잭션에서 버전을 올리고 리비전과 감사 기록을 남기므로 Task의 ETag와 리비전이 태그와 어긋나지 않습니다.
+type TagRepository interface {
+	CreateTag(ctx context.Context, spec *domain.TagSpec) (*domain.Tag, error)
+	// ListTags 모든 태그를 이름 순서로 반환합니다.
+	ListTags(ctx context.Context) ([]*domain.Tag, error)
+	GetTag(ctx context.Context, id domain.TagID) (*domain.Tag, error)
+	UpdateTag(ctx context.Context, tag *domain.Tag, stamp domain.Stamp) (*domain.Tag, error)
+	// DeleteTag 태그를 삭제하고 태그가 붙은 모든 Task에서 뗍니다.
+	DeleteTag(ctx context.Context, id domain.TagID, stamp domain.Stamp) error
+}
+
+// DependencyRepository Task 사이의 의존성 저장소입니다.
+// AddDependency는 두 Task 중 하나라도 없으면 ErrTaskNotFound를, 의존성을 따라 순환이 생기면 domain.ErrDependencyCycle을 반환하며,
+// 이미 있는 의존성이면 아무 일도 하지 않습니다. 순환 검사와 추가는 동시에 추가되는 의존성에 대해서도 원자적입니다.
+// RemoveDependency는 의존성이 없으면 ErrDependencyNotFound를 반환합니다.
+type DependencyRepository interface {
+	AddDependency(ctx context.Context, dependency domain.Dependency) error
+	RemoveDependency(ctx context.Context, dependency domain.Dependency) error
+	// ListDependencies Task가 ids 중 하나인 의존성을 (Task, DependsOn) 순서로 반환합니다.
+	ListDependencies(ctx context.Context, ids []domain.TaskID) ([]domain.Dependency, error)
+	// ListDependents DependsOn이 id인 의존성을 Task 순서로 반환합니다.
+	ListDependents(ctx context.Context, id domain.TaskID) ([]domain.Dependency, error)
+}
+
+// AuditRepository Task 변경의 감사 기록 저장소입니다. 기록은 Task를 바꾸는 메서드가 같은 트랜잭션에서 남기므로
+// 변경이 저장되지 않으면 기록도 남지 않습니다. 기록의 ID는 기록을 만든 순서대로 커지지만, 동시에 실행된 트랜잭션
+// 중 ID가 작은 쪽이 나중에 커밋될 수 있으므로 이미 지나간 커서보다 앞에 새 기록이 나타날 수 있습니다. 따라서 커서로
+// 새 기록을 이어 받는 용도(tail)로는 빠짐없는 조회를 보장하지 않습니다. Task를 영구 삭제해도 그 Task의 기록은 남습니다.
+type AuditRepository interface {
+	ListAuditEntries(ctx context.Context, query *AuditQuery) (*AuditPage, error)
+}
+
+// RevisionRepository Task의 버전별 스냅샷(리비전) 저장소입니다. 리비전 번호는 그 리비전의 Task 버전이며,
+// CreateTask와 UpdateTask가 저장한 Task를 같은 트랜잭션에서 리비전으로 기록합니다.
+// 리비전의 태그는 저장 당시의 값이며, DeletedAt은 항상 nil입니다. 영구 삭제하면 리비전도 함께 삭제합니다.
+type RevisionRepository interface {
+	// ListRevisions Task의 리비전을 버전 순서로 반환합니다. 리비전이 없으면 빈 목록을 반환합니다.
+	ListRevisions(ctx context.Context, id domain.TaskID) ([]*domain.Task, error)
+	// GetRevision 리비전이 없으면 ErrRevisionNotFound를 반환합니다.
+	GetRevision(ctx context.Context, id domain.TaskID, version int) (*domain.Task, error)
+}
+
+// CommentRepository Task에 달린 댓글 저장소입니다. 답글은 같은 Task의 다른 댓글을 ParentID로 가리킵니다.
+// CreateComment는 Task가 없거나 휴지통에 있으면 ErrTaskNotFound를, parentID인 댓글이 같은 Task에 없으면
+// ErrCommentNotFound를 반환합니다. 휴지통에 있는 Task의 댓글은 복원할 수 있도록 유지됩니다.
+type CommentRepository interface {
+	CreateComment(
+		ctx context.Context,
+		taskID domain.TaskID,
+		parentID domain.CommentID,
+		spec *domain.CommentSpec,
+		stamp domain.Stamp,
+	) (*domain.Comment, error)
+	// ListComments Task의 댓글을 작성 순서로 반환합니다.
+	ListComments(ctx context.Context, taskID domain.TaskID) ([]*domain.Comment, error)
+	GetComment(ctx context.Context, id domain.CommentID) (*domain.Comment, error)
+	// UpdateComment 댓글의 본문과 수정 정보를 저장합니다.
+	UpdateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error)
+	// DeleteComment 댓글과 그 아래의 모든 답글을 작성자와 관계없이 삭제합니다.
+	DeleteComment(ctx context.Context, id domain.CommentID) error
+}
+
+// AttachmentRepository Task에 첨부된 파일의 메타데이터 저장소입니다. 파일 본문은 blob 저장소에 따로 저장합니다.
+// CreateAttachment는 Task가 없거나 휴지통에 있으면 ErrTaskNotFound를 반환합니다.
+// 휴지통에 있는 Task의 첨부 파일은 복원할 수 있도록 유지됩니다.
+type AttachmentRepository interface {
+	CreateAttachment(
+		ctx context.Context,
+		taskID domain.TaskID,
+		spec *domain.AttachmentSpec,
+		blob domain.AttachmentBlob,
+		stamp domain.Stamp,
+	) (*domain.Attachment, error)
+	// ListAttachments Task의 첨부 파일을 업로드 순서로 반환합니다.
+	ListAttachments(ctx context.Context, taskID domain.TaskID) ([]*domain.Attachment, error)
+	GetAttachment(ctx context.Context, id domain.AttachmentID) (*domain.Attachment, error)
+	DeleteAttachment(ctx context.Context, id domain.AttachmentID) error
+	// ListPurgedAttachments 영구 삭제된 Task에 남아 있는 첨부 파일을 ID 순서로 최대 limit개 반환합니다.
+	// 휴지통에 있는 Task의 첨부 파일은 반환하지 않습니다.
+	ListPurgedAttachments(ctx context.Context, limit int) ([]*domain.Attachment, error)
+}
+
+// ProjectRepository 프로젝트 저장소입니다. 프로젝트 키는 유일하며, 중복되면 ErrDuplicateProject를 반환합니다.
+// DeleteProject는 휴지통에 있는 것을 포함하여 프로젝트에 속한 Task가 하나라도 있으면 ErrProjectNotEmpty를 반환합니다.
+type ProjectRepository interface {
+	CreateProject(
+		ctx context.Context,
+		key domain.ProjectKey,
+		spec *domain.ProjectSpec,
+		stamp domain.Stamp,
+	) (*domain.Project, error)
+	// ListProjects 모든 프로젝트를 키 순서로 반환합니다.
+	ListProjects(ctx context.Context) ([]*domain.Project, error)
+	GetProject(ctx context.Context, id domain.ProjectID) (*domain.Project, error)
+	// UpdateProject 프로젝트의 키를 제외한 내용과 수정 정보를 저장합니다. 저장된 버전이 project.Version()과 다르면
+	// ErrProjectConflict를 반환하고, 성공하면 버전을 1 증가시킵니다.
+	UpdateProject(ctx context.Context, project *domain.Project) (*domain.Project, error)
+	DeleteProject(ctx context.Context, id domain.ProjectID) error
 }
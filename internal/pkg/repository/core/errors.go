@@ -3,5 +3,18 @@ package core
 import "errors"
 
 var (
-	ErrTaskNotFound = errors.New("task not found")
+	ErrTaskNotFound       = errors.New("task not found")
+	ErrConflict           = errors.New("task was modified concurrently")
+	ErrInvalidQuery       = errors.New("invalid query")
+	ErrInvalidCursor      = errors.New("invalid cursor")
+	ErrTagNotFound        = errors.New("tag not found")
+	ErrDuplicateTag       = errors.New("tag name already exists")
+	ErrDependencyNotFound = errors.New("dependency not found")
+	ErrRevisionNotFound   = errors.New("revision not found")
+	ErrCommentNotFound    = errors.New("comment not found")
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	ErrProjectNotFound    = errors.New("project not found")
+	ErrDuplicateProject   = errors.New("project key already exists")
+	ErrProjectNotEmpty    = errors.New("project still has tasks")
+	ErrProjectConflict    = errors.New("project was modified concurrently")
 )
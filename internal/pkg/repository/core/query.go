@@ -0,0 +1,331 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// TimeKeyLayout 시각 정렬 키의 형식입니다. UTC 고정 길이이므로 문자열 비교가 시각 비교와 같습니다.
+const TimeKeyLayout = "2006-01-02T15:04:05.000000000Z"
+
+type TaskSortField string
+
+const (
+	TaskSortID     TaskSortField = "id"
+	TaskSortTitle  TaskSortField = "title"
+	TaskSortStatus TaskSortField = "status"
+
+	TaskSortCreatedAt TaskSortField = "created_at"
+	TaskSortUpdatedAt TaskSortField = "updated_at"
+	TaskSortPriority  TaskSortField = "priority"
+	TaskSortDueDate   TaskSortField = "due_date"
+)
+
+// IsTime 정렬 필드가 시각 값인지 확인합니다.
+func (f TaskSortField) IsTime() bool {
+	return f == TaskSortCreatedAt || f == TaskSortUpdatedAt || f == TaskSortDueDate
+}
+
+// NoDueDate 마감일이 없는 Task의 정렬 값입니다. 오름차순에서는 마감일이 있는 Task 뒤에 옵니다.
+func NoDueDate() time.Time {
+	return time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+}
+
+// TaskSort 정렬 기준입니다. 같은 값끼리는 항상 ID 순서로 정렬되어 순서가 안정적입니다.
+// 제목과 같은 문자열 값은 저장소의 collation과 관계없이 바이트(UTF-8) 순서로 비교합니다.
+type TaskSort struct {
+	Field      TaskSortField
+	Descending bool
+}
+
+// ParseTaskSort "title", "-title"과 같은 형식의 문자열을 TaskSort로 변환합니다. 빈 문자열은 ID 오름차순입니다.
+func ParseTaskSort(value string) (TaskSort, error) {
+	if value == "" {
+		return TaskSort{Field: TaskSortID, Descending: false}, nil
+	}
+
+	field, descending := strings.CutPrefix(value, "-")
+
+	sort := TaskSort{Field: TaskSortField(field), Descending: descending}
+	switch sort.Field {
+	case TaskSortID, TaskSortTitle, TaskSortStatus, TaskSortCreatedAt, TaskSortUpdatedAt,
+		TaskSortPriority, TaskSortDueDate:
+		return sort, nil
+	default:
+		return TaskSort{}, fmt.Errorf("%w: unknown sort field %q", ErrInvalidQuery, field)
+	}
+}
+
+func (s TaskSort) String() string {
+	if s.Descending {
+		return "-" + string(s.Field)
+	}
+
+	return string(s.Field)
+}
+
+// Key 정렬 필드에 해당하는 Task의 값을 반환합니다.
+func (s TaskSort) Key(task *domain.Task) string {
+	switch s.Field {
+	case TaskSortTitle:
+		return task.Title()
+	case TaskSortStatus:
+		return string(task.Status())
+	case TaskSortCreatedAt:
+		return task.Created().At().UTC().Format(TimeKeyLayout)
+	case TaskSortUpdatedAt:
+		return task.Updated().At().UTC().Format(TimeKeyLayout)
+	case TaskSortPriority:
+		return strconv.Itoa(task.Priority().Rank())
+	case TaskSortDueDate:
+		dueDate := task.DueDate()
+		if dueDate == nil {
+			return NoDueDate().Format(TimeKeyLayout)
+		}
+
+		return dueDate.UTC().Format(TimeKeyLayout)
+	case TaskSortID:
+		return string(task.ID())
+	default:
+		return string(task.ID())
+	}
+}
+
+// TagMatch 여러 태그로 필터링할 때 하나라도 붙은 Task(any)와 모두 붙은 Task(all) 중 무엇을 찾을지 정합니다.
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
+// ParseTagMatch 문자열을 TagMatch로 변환합니다. 빈 문자열은 TagMatchAny입니다.
+func ParseTagMatch(value string) (TagMatch, error) {
+	switch TagMatch(value) {
+	case "", TagMatchAny:
+		return TagMatchAny, nil
+	case TagMatchAll:
+		return TagMatchAll, nil
+	default:
+		return "", fmt.Errorf("%w: unknown tag match %q", ErrInvalidQuery, value)
+	}
+}
+
+type TaskFilter struct {
+	// IDs 비어 있지 않으면 이 ID의 Task만 조회합니다.
+	IDs []domain.TaskID
+	// ParentID 비어 있지 않으면 이 Task의 바로 아래 하위 Task만 조회합니다.
+	ParentID domain.TaskID
+	// ProjectID 비어 있지 않으면 이 프로젝트에 속한 Task만 조회합니다.
+	ProjectID domain.ProjectID
+	// Key 비어 있지 않으면 이 키의 Task만 조회합니다.
+	Key        string
+	Statuses   []domain.TaskStatus
+	Priorities []domain.TaskPriority
+	// Tags 태그 이름입니다. TagMatch에 따라 하나라도 또는 모두 붙은 Task만 조회합니다.
+	Tags     []string
+	TagMatch TagMatch
+	// Search 제목에 포함된 문자열 (대소문자 무시)
+	Search string
+	// DueBefore 마감일이 이 시각보다 이른 Task만 조회합니다. 마감일이 없는 Task는 제외됩니다.
+	DueBefore *time.Time
+	// DueAfter 마감일이 이 시각과 같거나 늦은 Task만 조회합니다. 마감일이 없는 Task는 제외됩니다.
+	DueAfter *time.Time
+	// OverdueAt 이 시각 기준으로 마감일이 지났지만 끝나지 않은 Task만 조회합니다.
+	OverdueAt *time.Time
+	// Deleted true이면 휴지통에 있는 Task만, false이면 삭제되지 않은 Task만 조회합니다.
+	Deleted bool
+}
+
+// Match Task가 필터 조건을 만족하는지 확인합니다.
+func (f *TaskFilter) Match(task *domain.Task) bool {
+	if !f.matchIdentity(task) || !f.matchStatusAndPriority(task) {
+		return false
+	}
+
+	if f.Search != "" && !strings.Contains(strings.ToLower(task.Title()), strings.ToLower(f.Search)) {
+		return false
+	}
+
+	if f.OverdueAt != nil && !task.IsOverdue(*f.OverdueAt) {
+		return false
+	}
+
+	if !f.matchTags(task.Tags()) {
+		return false
+	}
+
+	return f.matchDueDate(task.DueDate())
+}
+
+func (f *TaskFilter) matchIdentity(task *domain.Task) bool {
+	if (task.DeletedAt() != nil) != f.Deleted {
+		return false
+	}
+
+	if len(f.IDs) > 0 && !slices.Contains(f.IDs, task.ID()) {
+		return false
+	}
+
+	if f.ProjectID != "" && task.ProjectID() != f.ProjectID {
+		return false
+	}
+
+	if f.Key != "" && task.Key() != f.Key {
+		return false
+	}
+
+	return f.ParentID == "" || task.ParentID() == f.ParentID
+}
+
+func (f *TaskFilter) matchStatusAndPriority(task *domain.Task) bool {
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, task.Status()) {
+		return false
+	}
+
+	return len(f.Priorities) == 0 || slices.Contains(f.Priorities, task.Priority())
+}
+
+func (f *TaskFilter) matchTags(tags []*domain.Tag) bool {
+	if len(f.Tags) == 0 {
+		return true
+	}
+
+	matched := 0
+
+	for _, name := range f.Tags {
+		if slices.ContainsFunc(tags, func(tag *domain.Tag) bool { return tag.Name() == name }) {
+			matched++
+		}
+	}
+
+	if f.TagMatch == TagMatchAll {
+		return matched == len(f.Tags)
+	}
+
+	return matched > 0
+}
+
+func (f *TaskFilter) matchDueDate(dueDate *time.Time) bool {
+	if f.DueBefore == nil && f.DueAfter == nil {
+		return true
+	}
+
+	if dueDate == nil {
+		return false
+	}
+
+	if f.DueBefore != nil && !dueDate.Before(*f.DueBefore) {
+		return false
+	}
+
+	return f.DueAfter == nil || !dueDate.Before(*f.DueAfter)
+}
+
+type ListTasksQuery struct {
+	Filter TaskFilter
+	Sort   TaskSort
+	Limit  int
+	Cursor *Cursor
+}
+
+// Validate 쿼리 값을 검사합니다. Limit이 0이면 DefaultListLimit이 적용되고,
+// 태그 이름은 태그 저장 형식과 같이 소문자로 바꾸고 중복을 제거합니다.
+func (q *ListTasksQuery) Validate() error {
+	if q.Limit == 0 {
+		q.Limit = DefaultListLimit
+	}
+
+	if q.Filter.TagMatch == "" {
+		q.Filter.TagMatch = TagMatchAny
+	}
+
+	tags := make([]string, 0, len(q.Filter.Tags))
+
+	for _, tag := range q.Filter.Tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	q.Filter.Tags = tags
+
+	if q.Limit < 0 || q.Limit > MaxListLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidQuery, MaxListLimit)
+	}
+
+	if q.Cursor != nil && q.Cursor.Sort != q.Sort.String() {
+		return fmt.Errorf("%w: cursor was issued for sort %q", ErrInvalidCursor, q.Cursor.Sort)
+	}
+
+	return nil
+}
+
+type TaskPage struct {
+	Tasks []*domain.Task
+	// NextCursor 다음 페이지가 없으면 nil입니다.
+	NextCursor *Cursor
+}
+
+// Cursor 마지막으로 반환된 Task의 정렬 값과 ID를 담는 keyset 커서입니다.
+type Cursor struct {
+	Sort  string        `json:"s"`
+	Value string        `json:"v"`
+	ID    domain.TaskID `json:"id"`
+}
+
+func NewCursor(sort TaskSort, task *domain.Task) *Cursor {
+	return &Cursor{
+		Sort:  sort.String(),
+		Value: sort.Key(task),
+		ID:    task.ID(),
+	}
+}
+
+// Encode 커서를 클라이언트에 전달할 불투명한 문자열로 인코딩합니다.
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c) //nolint:errchkjson
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	var cursor Cursor
+
+	err = json.Unmarshal(data, &cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	if cursor.ID == "" {
+		return nil, fmt.Errorf("%w: missing id", ErrInvalidCursor)
+	}
+
+	field, _ := strings.CutPrefix(cursor.Sort, "-")
+	if TaskSortField(field).IsTime() {
+		_, err = time.Parse(TimeKeyLayout, cursor.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+		}
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,145 @@
+package fake
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+)
+
+var _ core.AttachmentRepository = (*Repository)(nil)
+
+// CreateAttachment implements core.AttachmentRepository.
+func (r *Repository) CreateAttachment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	spec *domain.AttachmentSpec,
+	blob domain.AttachmentBlob,
+	stamp domain.Stamp,
+) (*domain.Attachment, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.liveTask(taskID); !exists {
+		return nil, core.ErrTaskNotFound
+	}
+
+	attachment := domain.NewAttachment(domain.AttachmentParams{
+		ID:      domain.AttachmentID(ulid.Make().String()),
+		TaskID:  taskID,
+		Name:    spec.Name(),
+		Blob:    blob,
+		Created: stamp,
+	})
+	r.attachments[attachment.ID()] = attachment
+
+	err := r.save()
+	if err != nil {
+		delete(r.attachments, attachment.ID())
+
+		return nil, err
+	}
+
+	return attachment.Clone(), nil
+}
+
+// ListAttachments implements core.AttachmentRepository.
+func (r *Repository) ListAttachments(ctx context.Context, taskID domain.TaskID) ([]*domain.Attachment, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var attachments []*domain.Attachment
+
+	for _, attachment := range r.attachments {
+		if attachment.TaskID() == taskID {
+			attachments = append(attachments, attachment.Clone())
+		}
+	}
+
+	// ID는 ULID이므로 ID 순서가 업로드 순서입니다.
+	slices.SortFunc(attachments, func(a, b *domain.Attachment) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return attachments, nil
+}
+
+// ListPurgedAttachments implements core.AttachmentRepository.
+func (r *Repository) ListPurgedAttachments(ctx context.Context, limit int) ([]*domain.Attachment, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var attachments []*domain.Attachment
+
+	for _, id := range slices.Sorted(maps.Keys(r.attachments)) {
+		if len(attachments) == limit {
+			break
+		}
+
+		attachment := r.attachments[id]
+		if _, exists := r.tasks[string(attachment.TaskID())]; !exists {
+			attachments = append(attachments, attachment.Clone())
+		}
+	}
+
+	return attachments, nil
+}
+
+// GetAttachment implements core.AttachmentRepository.
+func (r *Repository) GetAttachment(ctx context.Context, id domain.AttachmentID) (*domain.Attachment, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	attachment, exists := r.attachments[id]
+	if !exists {
+		return nil, core.ErrAttachmentNotFound
+	}
+
+	return attachment.Clone(), nil
+}
+
+// DeleteAttachment implements core.AttachmentRepository.
+func (r *Repository) DeleteAttachment(ctx context.Context, id domain.AttachmentID) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attachment, exists := r.attachments[id]
+	if !exists {
+		return core.ErrAttachmentNotFound
+	}
+
+	delete(r.attachments, id)
+
+	err := r.save()
+	if err != nil {
+		r.attachments[id] = attachment
+
+		return err
+	}
+
+	return nil
+}
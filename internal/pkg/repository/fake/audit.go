@@ -0,0 +1,51 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+)
+
+var _ core.AuditRepository = (*Repository)(nil)
+
+// appendAudit entries에 새 ID를 부여하여 감사 기록에 추가하고, 저장에 실패했을 때 되돌릴 이전 길이를 반환합니다.
+// 쓰기 락을 잡은 상태에서 호출해야 합니다.
+func (r *Repository) appendAudit(entries ...domain.AuditEntry) int {
+	length := len(r.audit)
+
+	for _, entry := range entries {
+		// ulid.Make는 같은 밀리초 안에서도 단조 증가하므로 ID 순서가 추가된 순서와 같습니다.
+		r.audit = append(r.audit, entry.WithID(domain.AuditEntryID(ulid.Make().String())))
+	}
+
+	return length
+}
+
+// ListAuditEntries implements core.AuditRepository.
+func (r *Repository) ListAuditEntries(ctx context.Context, query *core.AuditQuery) (*core.AuditPage, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var entries []domain.AuditEntry
+
+	for i := range r.audit {
+		entry := r.audit[i]
+		if entry.ID() <= query.After || !query.Match(&entry) {
+			continue
+		}
+
+		entries = append(entries, entry)
+
+		if len(entries) > query.Limit {
+			break
+		}
+	}
+
+	return core.NewAuditPage(entries, query.Limit), nil
+}
@@ -0,0 +1,174 @@
+package fake
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+)
+
+var _ core.CommentRepository = (*Repository)(nil)
+
+// CreateComment implements core.CommentRepository.
+func (r *Repository) CreateComment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	parentID domain.CommentID,
+	spec *domain.CommentSpec,
+	stamp domain.Stamp,
+) (*domain.Comment, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.liveTask(taskID); !exists {
+		return nil, core.ErrTaskNotFound
+	}
+
+	if parentID != "" {
+		parent, exists := r.comments[parentID]
+		if !exists || parent.TaskID() != taskID {
+			return nil, core.ErrCommentNotFound
+		}
+	}
+
+	comment := domain.NewComment(domain.CommentParams{
+		ID:       domain.CommentID(ulid.Make().String()),
+		TaskID:   taskID,
+		ParentID: parentID,
+		Body:     spec.Body(),
+		Created:  stamp,
+		Updated:  stamp,
+	})
+	r.comments[comment.ID()] = comment
+
+	err := r.save()
+	if err != nil {
+		delete(r.comments, comment.ID())
+
+		return nil, err
+	}
+
+	return comment.Clone(), nil
+}
+
+// ListComments implements core.CommentRepository.
+func (r *Repository) ListComments(ctx context.Context, taskID domain.TaskID) ([]*domain.Comment, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var comments []*domain.Comment
+
+	for _, comment := range r.comments {
+		if comment.TaskID() == taskID {
+			comments = append(comments, comment.Clone())
+		}
+	}
+
+	// ID는 ULID이므로 ID 순서가 작성 순서입니다.
+	slices.SortFunc(comments, func(a, b *domain.Comment) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
+
+	return comments, nil
+}
+
+// GetComment implements core.CommentRepository.
+func (r *Repository) GetComment(ctx context.Context, id domain.CommentID) (*domain.Comment, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	comment, exists := r.comments[id]
+	if !exists {
+		return nil, core.ErrCommentNotFound
+	}
+
+	return comment.Clone(), nil
+}
+
+// UpdateComment implements core.CommentRepository.
+func (r *Repository) UpdateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.comments[comment.ID()]
+	if !exists {
+		return nil, core.ErrCommentNotFound
+	}
+
+	// orm.Repository와 같이 본문과 수정 정보만 반영하고 작성 정보와 위치는 저장된 값을 유지합니다.
+	updated := domain.NewComment(domain.CommentParams{
+		ID:       stored.ID(),
+		TaskID:   stored.TaskID(),
+		ParentID: stored.ParentID(),
+		Body:     comment.Body(),
+		Created:  stored.Created(),
+		Updated:  comment.Updated(),
+	})
+	r.comments[comment.ID()] = updated
+
+	err := r.save()
+	if err != nil {
+		r.comments[comment.ID()] = stored
+
+		return nil, err
+	}
+
+	return updated.Clone(), nil
+}
+
+// DeleteComment implements core.CommentRepository.
+func (r *Repository) DeleteComment(ctx context.Context, id domain.CommentID) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.comments[id]; !exists {
+		return core.ErrCommentNotFound
+	}
+
+	comments := maps.Clone(r.comments)
+	deleted := map[domain.CommentID]bool{id: true}
+
+	// 답글은 항상 가리키는 댓글보다 나중에 작성되므로 ID 순서로 한 번 훑으면 모든 하위 답글을 찾습니다.
+	for _, commentID := range slices.Sorted(maps.Keys(r.comments)) {
+		if deleted[r.comments[commentID].ParentID()] {
+			deleted[commentID] = true
+		}
+	}
+
+	maps.DeleteFunc(r.comments, func(commentID domain.CommentID, _ *domain.Comment) bool {
+		return deleted[commentID]
+	})
+
+	err := r.save()
+	if err != nil {
+		r.comments = comments
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package fake
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+// AddDependency implements core.DependencyRepository.
+func (r *Repository) AddDependency(ctx context.Context, dependency domain.Dependency) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range []domain.TaskID{dependency.Task(), dependency.DependsOn()} {
+		if _, exists := r.liveTask(id); !exists {
+			return core.ErrTaskNotFound
+		}
+	}
+
+	if r.dependsOn(dependency.DependsOn(), dependency.Task()) {
+		return domain.ErrDependencyCycle
+	}
+
+	if _, exists := r.deps[dependency]; exists {
+		return nil
+	}
+
+	r.deps[dependency] = struct{}{}
+
+	err := r.save()
+	if err != nil {
+		delete(r.deps, dependency)
+
+		return err
+	}
+
+	return nil
+}
+
+// dependsOn from에서 의존성을 따라가 to에 도달할 수 있는지 확인합니다. 호출하는 쪽에서 r.mu를 잠가야 합니다.
+func (r *Repository) dependsOn(from, to domain.TaskID) bool {
+	visited := map[domain.TaskID]bool{from: true}
+
+	for frontier := []domain.TaskID{from}; len(frontier) > 0; {
+		if slices.Contains(frontier, to) {
+			return true
+		}
+
+		var next []domain.TaskID
+
+		for dep := range r.deps {
+			if slices.Contains(frontier, dep.Task()) && !visited[dep.DependsOn()] {
+				next = append(next, dep.DependsOn())
+				visited[dep.DependsOn()] = true
+			}
+		}
+
+		frontier = next
+	}
+
+	return false
+}
+
+// RemoveDependency implements core.DependencyRepository.
+func (r *Repository) RemoveDependency(ctx context.Context, dependency domain.Dependency) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.deps[dependency]; !exists {
+		return core.ErrDependencyNotFound
+	}
+
+	delete(r.deps, dependency)
+
+	err := r.save()
+	if err != nil {
+		r.deps[dependency] = struct{}{}
+
+		return err
+	}
+
+	return nil
+}
+
+// ListDependencies implements core.DependencyRepository.
+func (r *Repository) ListDependencies(ctx context.Context, ids []domain.TaskID) ([]domain.Dependency, error) {
+	return r.listDependencies(ctx, func(dep domain.Dependency) bool {
+		return slices.Contains(ids, dep.Task())
+	})
+}
+
+// ListDependents implements core.DependencyRepository.
+func (r *Repository) ListDependents(ctx context.Context, id domain.TaskID) ([]domain.Dependency, error) {
+	return r.listDependencies(ctx, func(dep domain.Dependency) bool {
+		return dep.DependsOn() == id
+	})
+}
+
+// listDependencies match를 만족하는 의존성을 (Task, DependsOn) 순서로 반환합니다.
+func (r *Repository) listDependencies(
+	ctx context.Context,
+	match func(dep domain.Dependency) bool,
+) ([]domain.Dependency, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var deps []domain.Dependency
+
+	for dep := range r.deps {
+		if match(dep) {
+			deps = append(deps, dep)
+		}
+	}
+
+	slices.SortFunc(deps, func(a, b domain.Dependency) int {
+		return cmp.Or(cmp.Compare(a.Task(), b.Task()), cmp.Compare(a.DependsOn(), b.DependsOn()))
+	})
+
+	return deps, nil
+}
@@ -0,0 +1,309 @@
+package fake_test
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/conformance"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/fake"
+)
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	conformance.Run(t, func(t *testing.T) core.Repository {
+		t.Helper()
+
+		return fake.NewRepository()
+	})
+}
+
+func TestPersistentRepository(t *testing.T) {
+	t.Parallel()
+
+	conformance.Run(t, func(t *testing.T) core.Repository {
+		t.Helper()
+
+		repo, err := fake.NewPersistentRepository(filepath.Join(t.TempDir(), "snapshot.json"))
+		if err != nil {
+			t.Fatalf("NewPersistentRepository: %v", err)
+		}
+
+		return repo
+	})
+}
+
+func TestPersistentRepositoryReload(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	stamp := domain.NewStamp(time.Date(2026, time.January, 2, 3, 4, 5, 6000, time.UTC), "tester")
+
+	repo, err := fake.NewPersistentRepository(path)
+	if err != nil {
+		t.Fatalf("NewPersistentRepository: %v", err)
+	}
+
+	created, err := repo.CreateTask(t.Context(), mustSpec(t, "title", "description", domain.TaskStatusDone), stamp)
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	tag := mustCreateTag(t, repo, "work", "#336699")
+	checklist := []domain.ChecklistItem{domain.NewChecklistItem("item", "step", true)}
+
+	created, err = repo.UpdateTask(t.Context(), created.AddTag(tag).WithChecklist(checklist))
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	reloaded, err := fake.NewPersistentRepository(path)
+	if err != nil {
+		t.Fatalf("NewPersistentRepository: %v", err)
+	}
+
+	task, err := reloaded.GetTask(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetTask after reload: %v", err)
+	}
+
+	want := domain.NewTask(domain.TaskParams{
+		ID:          created.ID(),
+		Title:       "title",
+		Description: "description",
+		Status:      domain.TaskStatusDone,
+		Priority:    domain.TaskPriorityMedium,
+		DueDate:     nil,
+		ParentID:    "",
+		ProjectID:   "",
+		Key:         "",
+		Rank:        "",
+		Tags:        []*domain.Tag{tag},
+		Checklist:   checklist,
+		Version:     2,
+		Created:     stamp,
+		Updated:     stamp,
+		DeletedAt:   nil,
+	})
+	if !reflect.DeepEqual(task, want) {
+		t.Fatalf("reloaded task = %+v, want %+v", task, want)
+	}
+
+	assertLastRevision(t, reloaded, want)
+}
+
+func TestPersistentRepositoryReloadAttachments(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	stamp := domain.NewStamp(time.Date(2026, time.January, 2, 3, 4, 5, 6000, time.UTC), "tester")
+
+	repo, err := fake.NewPersistentRepository(path)
+	if err != nil {
+		t.Fatalf("NewPersistentRepository: %v", err)
+	}
+
+	task, err := repo.CreateTask(t.Context(), mustSpec(t, "title", "", domain.TaskStatusOpen), stamp)
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	spec, err := domain.NewAttachmentSpec("log.txt")
+	if err != nil {
+		t.Fatalf("NewAttachmentSpec: %v", err)
+	}
+
+	blob := domain.AttachmentBlob{Key: "tasks/log", ContentType: "text/plain; charset=utf-8", Size: 3, Checksum: "abc"}
+
+	created, err := repo.CreateAttachment(t.Context(), task.ID(), spec, blob, stamp)
+	if err != nil {
+		t.Fatalf("CreateAttachment: %v", err)
+	}
+
+	reloaded, err := fake.NewPersistentRepository(path)
+	if err != nil {
+		t.Fatalf("NewPersistentRepository: %v", err)
+	}
+
+	attachment, err := reloaded.GetAttachment(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetAttachment after reload: %v", err)
+	}
+
+	if !reflect.DeepEqual(attachment, created) {
+		t.Fatalf("reloaded attachment = %+v, want %+v", attachment, created)
+	}
+}
+
+func TestPersistentRepositoryReloadProjects(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	stamp := domain.NewStamp(time.Date(2026, time.January, 2, 3, 4, 5, 6000, time.UTC), "tester")
+
+	repo, err := fake.NewPersistentRepository(path)
+	if err != nil {
+		t.Fatalf("NewPersistentRepository: %v", err)
+	}
+
+	spec, err := domain.NewProjectSpec(domain.ProjectSpecParams{
+		Name:            "운영",
+		Description:     "",
+		DefaultPriority: domain.TaskPriorityHigh,
+	})
+	if err != nil {
+		t.Fatalf("NewProjectSpec: %v", err)
+	}
+
+	created, err := repo.CreateProject(t.Context(), "OPS", spec, stamp)
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+
+	mustCreateInProject(t, repo, created.ID(), stamp)
+
+	reloaded, err := fake.NewPersistentRepository(path)
+	if err != nil {
+		t.Fatalf("NewPersistentRepository: %v", err)
+	}
+
+	project, err := reloaded.GetProject(t.Context(), created.ID())
+	if err != nil {
+		t.Fatalf("GetProject after reload: %v", err)
+	}
+
+	if !reflect.DeepEqual(project, created) {
+		t.Fatalf("reloaded project = %+v, want %+v", project, created)
+	}
+
+	// 다시 읽은 뒤에도 이어지는 번호를 배정합니다.
+	if task := mustCreateInProject(t, reloaded, created.ID(), stamp); task.Key() != "OPS-2" {
+		t.Fatalf("Key after reload = %q, want OPS-2", task.Key())
+	}
+}
+
+func assertLastRevision(t *testing.T, repo *fake.Repository, want *domain.Task) {
+	t.Helper()
+
+	revisions, err := repo.ListRevisions(t.Context(), want.ID())
+	if err != nil {
+		t.Fatalf("ListRevisions: %v", err)
+	}
+
+	if len(revisions) != want.Version() || !reflect.DeepEqual(revisions[len(revisions)-1], want) {
+		t.Fatalf("revisions = %+v, want last revision %+v", revisions, want)
+	}
+}
+
+func TestRepositoryConcurrentUpdates(t *testing.T) {
+	t.Parallel()
+
+	const workers = 16
+
+	repo := fake.NewRepository()
+	stamp := domain.NewStamp(time.Now(), "tester")
+
+	created, err := repo.CreateTask(t.Context(), mustSpec(t, "title", "", domain.TaskStatusOpen), stamp)
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	updated := created.SetSpec(mustSpec(t, "updated", "", ""))
+
+	var (
+		wg        sync.WaitGroup
+		succeeded atomic.Int32
+	)
+
+	for range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := repo.UpdateTask(t.Context(), updated)
+			if err == nil {
+				succeeded.Add(1)
+			} else if !errors.Is(err, core.ErrConflict) {
+				t.Errorf("UpdateTask error = %v, want %v", err, core.ErrConflict)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got := succeeded.Load(); got != 1 {
+		t.Fatalf("%d concurrent updates succeeded, want 1", got)
+	}
+}
+
+func mustSpec(t *testing.T, title, description string, status domain.TaskStatus) *domain.TaskSpec {
+	t.Helper()
+
+	spec, err := domain.NewTaskSpec(domain.TaskSpecParams{
+		Title:       title,
+		Description: description,
+		Status:      status,
+		Priority:    "",
+		DueDate:     nil,
+		ParentID:    "",
+		ProjectID:   "",
+	})
+	if err != nil {
+		t.Fatalf("NewTaskSpec: %v", err)
+	}
+
+	return spec
+}
+
+func mustCreateTag(t *testing.T, repo *fake.Repository, name, color string) *domain.Tag {
+	t.Helper()
+
+	spec, err := domain.NewTagSpec(name, color)
+	if err != nil {
+		t.Fatalf("NewTagSpec: %v", err)
+	}
+
+	tag, err := repo.CreateTag(t.Context(), spec)
+	if err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+
+	return tag
+}
+
+func mustCreateInProject(
+	t *testing.T,
+	repo *fake.Repository,
+	projectID domain.ProjectID,
+	stamp domain.Stamp,
+) *domain.Task {
+	t.Helper()
+
+	spec, err := domain.NewTaskSpec(domain.TaskSpecParams{
+		Title:       "title",
+		Description: "",
+		Status:      domain.TaskStatusOpen,
+		Priority:    "",
+		DueDate:     nil,
+		ParentID:    "",
+		ProjectID:   projectID,
+	})
+	if err != nil {
+		t.Fatalf("NewTaskSpec: %v", err)
+	}
+
+	task, err := repo.CreateTask(t.Context(), spec, stamp)
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	return task
+}
@@ -1,77 +1,289 @@
 package fake
 
 import (
+	"cmp"
+	"context"
 	"fmt"
+	"slices"
+	"sync"
 
 	"github.com/neatflowcv/tasker/internal/pkg/domain"
 	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
 )
 
 var _ core.Repository = (*Repository)(nil)
 
+// Repository 메모리 기반 core.Repository 구현입니다. 동시에 사용해도 안전하며,
+// 저장된 Task를 외부에 노출하지 않도록 항상 복사본을 주고받습니다.
+// 휴지통에 있는 Task도 tasks에 함께 저장되며 DeletedAt으로 구분합니다.
 type Repository struct {
-	tasks   map[string]*domain.Task
-	counter int
+	mu    sync.RWMutex
+	tasks map[string]*domain.Task
+	tags  map[string]*domain.Tag
+	deps  map[domain.Dependency]struct{}
+	// audit 감사 기록을 ID 순서(추가된 순서)로 담습니다.
+	audit []domain.AuditEntry
+	// revisions Task별 리비전을 버전 순서로 담습니다.
+	revisions   map[domain.TaskID][]*domain.Task
+	comments    map[domain.CommentID]*domain.Comment
+	attachments map[domain.AttachmentID]*domain.Attachment
+	projects    map[domain.ProjectID]*domain.Project
+	// projectSeqs 프로젝트별로 마지막에 배정한 Task 번호입니다.
+	projectSeqs map[domain.ProjectID]int
+
+	// snapshotPath 비어 있지 않으면 변경될 때마다 전체 상태를 이 JSON 파일에 저장합니다.
+	snapshotPath string
 }
 
 // NewRepository creates a new fake repository
 func NewRepository() *Repository {
 	return &Repository{
-		tasks:   make(map[string]*domain.Task),
-		counter: 0,
+		mu:           sync.RWMutex{},
+		tasks:        make(map[string]*domain.Task),
+		tags:         make(map[string]*domain.Tag),
+		deps:         make(map[domain.Dependency]struct{}),
+		audit:        nil,
+		revisions:    make(map[domain.TaskID][]*domain.Task),
+		comments:     make(map[domain.CommentID]*domain.Comment),
+		attachments:  make(map[domain.AttachmentID]*domain.Attachment),
+		projects:     make(map[domain.ProjectID]*domain.Project),
+		projectSeqs:  make(map[domain.ProjectID]int),
+		snapshotPath: "",
+	}
+}
+
+// NewPersistentRepository creates a fake repository backed by a JSON snapshot file.
+// An existing snapshot at path is loaded; a missing file starts an empty repository.
+func NewPersistentRepository(path string) (*Repository, error) {
+	repo := NewRepository()
+	repo.snapshotPath = path
+
+	err := repo.load()
+	if err != nil {
+		return nil, err
 	}
+
+	return repo, nil
 }
 
-// CreateTask implements core.Repository.
-func (r *Repository) CreateTask(spec *domain.TaskSpec) (*domain.Task, error) {
-	r.counter++
-	id := domain.TaskID(fmt.Sprintf("task-%d", r.counter))
+// CreateTask implements core.TaskRepository.
+func (r *Repository) CreateTask(
+	ctx context.Context,
+	spec *domain.TaskSpec,
+	stamp domain.Stamp,
+) (*domain.Task, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
-	task := domain.NewTask(id, spec.Title(), spec.Description())
+	key, err := r.nextTaskKey(spec.ProjectID())
+	if err != nil {
+		return nil, err
+	}
+
+	// orm.Repository와 동일하게 생성 순서로 정렬되는 ULID를 사용합니다.
+	id := domain.TaskID(ulid.Make().String())
+
+	task := domain.NewTask(domain.TaskParams{
+		ID:          id,
+		Title:       spec.Title(),
+		Description: spec.Description(),
+		Status:      spec.Status(),
+		Priority:    spec.Priority(),
+		DueDate:     spec.DueDate(),
+		ParentID:    spec.ParentID(),
+		ProjectID:   spec.ProjectID(),
+		Key:         key,
+		Rank:        "",
+		Tags:        nil,
+		Checklist:   nil,
+		Version:     1,
+		Created:     stamp,
+		Updated:     stamp,
+		DeletedAt:   nil,
+	})
 	r.tasks[string(id)] = task
+	r.revisions[id] = []*domain.Task{task}
+	audited := r.appendAudit(domain.NewAuditEntry(domain.AuditActionCreate, nil, task, stamp))
+
+	err = r.save()
+	if err != nil {
+		delete(r.tasks, string(id))
+		delete(r.revisions, id)
+		r.audit = r.audit[:audited]
+
+		if key != "" {
+			r.projectSeqs[spec.ProjectID()]--
+		}
+
+		return nil, err
+	}
 
-	return task, nil
+	return task.Clone(), nil
 }
 
-// DeleteTask implements core.Repository.
-func (r *Repository) DeleteTask(id domain.TaskID) error {
-	if _, exists := r.tasks[string(id)]; !exists {
+// DeleteTask implements core.TaskRepository.
+func (r *Repository) DeleteTask(ctx context.Context, id domain.TaskID, stamp domain.Stamp) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task, exists := r.liveTask(id)
+	if !exists {
 		return core.ErrTaskNotFound
 	}
 
-	delete(r.tasks, string(id))
+	deletedAt := stamp.At()
+	deleted := task.WithDeletedAt(&deletedAt)
+	r.tasks[string(id)] = deleted
+	audited := r.appendAudit(domain.NewAuditEntry(domain.AuditActionDelete, task, deleted, stamp))
+
+	err := r.save()
+	if err != nil {
+		r.tasks[string(id)] = task
+		r.audit = r.audit[:audited]
+
+		return err
+	}
 
 	return nil
 }
 
-// GetTask implements core.Repository.
-func (r *Repository) GetTask(id domain.TaskID) (*domain.Task, error) {
-	task, exists := r.tasks[string(id)]
+// GetTask implements core.TaskRepository.
+func (r *Repository) GetTask(ctx context.Context, id domain.TaskID) (*domain.Task, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	task, exists := r.liveTask(id)
 	if !exists {
 		return nil, core.ErrTaskNotFound
 	}
 
-	return task, nil
+	return task.Clone(), nil
 }
 
-// ListTasks implements core.Repository.
-func (r *Repository) ListTasks() ([]*domain.Task, error) {
+// ListTasks implements core.TaskRepository.
+func (r *Repository) ListTasks(ctx context.Context, query *core.ListTasksQuery) (*core.TaskPage, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	tasks := make([]*domain.Task, 0, len(r.tasks))
 
 	for _, task := range r.tasks {
-		tasks = append(tasks, task)
+		if !query.Filter.Match(task) {
+			continue
+		}
+
+		if query.Cursor != nil && compareTasks(query.Sort, task, query.Cursor.Value, query.Cursor.ID) <= 0 {
+			continue
+		}
+
+		tasks = append(tasks, task.Clone())
+	}
+
+	slices.SortFunc(tasks, func(a, b *domain.Task) int {
+		return compareTasks(query.Sort, a, query.Sort.Key(b), b.ID())
+	})
+
+	page := &core.TaskPage{Tasks: tasks, NextCursor: nil}
+	if len(tasks) > query.Limit {
+		page.Tasks = tasks[:query.Limit]
+		page.NextCursor = core.NewCursor(query.Sort, page.Tasks[query.Limit-1])
 	}
 
-	return tasks, nil
+	return page, nil
 }
 
-// UpdateTask implements core.Repository.
-func (r *Repository) UpdateTask(task *domain.Task) (*domain.Task, error) {
-	if _, exists := r.tasks[string(task.ID())]; !exists {
+// UpdateTask implements core.TaskRepository.
+func (r *Repository) UpdateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.liveTask(task.ID())
+	if !exists {
 		return nil, core.ErrTaskNotFound
 	}
 
-	r.tasks[string(task.ID())] = task
+	if stored.Version() != task.Version() {
+		return nil, core.ErrConflict
+	}
+
+	// 저장된 태그 정보로 바꾸어 저장하므로 호출자가 가진 태그가 오래되었어도 최신 이름과 색상이 유지됩니다.
+	tags := make([]*domain.Tag, 0, len(task.Tags()))
+	for _, tag := range task.Tags() {
+		storedTag, exists := r.tags[string(tag.ID())]
+		if !exists {
+			return nil, core.ErrTagNotFound
+		}
+
+		tags = append(tags, storedTag)
+	}
+
+	updated := task.WithTags(tags).WithVersion(task.Version() + 1)
+	r.tasks[string(task.ID())] = updated
+	r.revisions[task.ID()] = append(r.revisions[task.ID()], updated)
+	audited := r.appendAudit(domain.NewAuditEntry(domain.AuditActionUpdate, stored, updated, updated.Updated()))
+
+	err := r.save()
+	if err != nil {
+		r.tasks[string(task.ID())] = stored
+		r.revisions[task.ID()] = r.revisions[task.ID()][:len(r.revisions[task.ID()])-1]
+		r.audit = r.audit[:audited]
+
+		return nil, err
+	}
+
+	return updated.Clone(), nil
+}
+
+// liveTask 휴지통에 있지 않은 Task를 찾습니다. 락을 잡은 상태에서 호출해야 합니다.
+func (r *Repository) liveTask(id domain.TaskID) (*domain.Task, bool) {
+	task, exists := r.tasks[string(id)]
+	if !exists || task.DeletedAt() != nil {
+		return nil, false
+	}
+
+	return task, true
+}
 
-	return task, nil
+// compareTasks 정렬 방향을 반영하여 task를 (key, id) 위치와 비교합니다.
+func compareTasks(sort core.TaskSort, task *domain.Task, key string, id domain.TaskID) int {
+	ret := cmp.Or(
+		cmp.Compare(sort.Key(task), key),
+		cmp.Compare(task.ID(), id),
+	)
+	if sort.Descending {
+		return -ret
+	}
+
+	return ret
+}
+
+// checkContext 요청이 취소되었거나 deadline이 지났으면 에러를 반환합니다.
+func checkContext(ctx context.Context) error {
+	err := ctx.Err()
+	if err != nil {
+		return fmt.Errorf("fake repository: %w", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,188 @@
+package fake
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+)
+
+var _ core.ProjectRepository = (*Repository)(nil)
+
+// CreateProject implements core.ProjectRepository.
+func (r *Repository) CreateProject(
+	ctx context.Context,
+	key domain.ProjectKey,
+	spec *domain.ProjectSpec,
+	stamp domain.Stamp,
+) (*domain.Project, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, project := range r.projects {
+		if project.Key() == key {
+			return nil, core.ErrDuplicateProject
+		}
+	}
+
+	project := domain.NewProject(domain.ProjectParams{
+		ID:              domain.ProjectID(ulid.Make().String()),
+		Key:             key,
+		Name:            spec.Name(),
+		Description:     spec.Description(),
+		DefaultPriority: spec.DefaultPriority(),
+		Columns:         nil,
+		Version:         1,
+		Created:         stamp,
+		Updated:         stamp,
+	})
+	r.projects[project.ID()] = project
+
+	err := r.save()
+	if err != nil {
+		delete(r.projects, project.ID())
+
+		return nil, err
+	}
+
+	return project.Clone(), nil
+}
+
+// ListProjects implements core.ProjectRepository.
+func (r *Repository) ListProjects(ctx context.Context) ([]*domain.Project, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	projects := make([]*domain.Project, 0, len(r.projects))
+	for _, project := range r.projects {
+		projects = append(projects, project.Clone())
+	}
+
+	slices.SortFunc(projects, func(a, b *domain.Project) int {
+		return cmp.Compare(a.Key(), b.Key())
+	})
+
+	return projects, nil
+}
+
+// GetProject implements core.ProjectRepository.
+func (r *Repository) GetProject(ctx context.Context, id domain.ProjectID) (*domain.Project, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	project, exists := r.projects[id]
+	if !exists {
+		return nil, core.ErrProjectNotFound
+	}
+
+	return project.Clone(), nil
+}
+
+// UpdateProject implements core.ProjectRepository.
+func (r *Repository) UpdateProject(ctx context.Context, project *domain.Project) (*domain.Project, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.projects[project.ID()]
+	if !exists {
+		return nil, core.ErrProjectNotFound
+	}
+
+	if stored.Version() != project.Version() {
+		return nil, core.ErrProjectConflict
+	}
+
+	// 키는 Task 키에 쓰이므로 저장된 값을 유지합니다.
+	updated := domain.NewProject(domain.ProjectParams{
+		ID:              stored.ID(),
+		Key:             stored.Key(),
+		Name:            project.Name(),
+		Description:     project.Description(),
+		DefaultPriority: project.DefaultPriority(),
+		Columns:         project.Columns(),
+		Version:         project.Version() + 1,
+		Created:         stored.Created(),
+		Updated:         project.Updated(),
+	})
+	r.projects[project.ID()] = updated
+
+	err := r.save()
+	if err != nil {
+		r.projects[project.ID()] = stored
+
+		return nil, err
+	}
+
+	return updated.Clone(), nil
+}
+
+// DeleteProject implements core.ProjectRepository.
+func (r *Repository) DeleteProject(ctx context.Context, id domain.ProjectID) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.projects[id]
+	if !exists {
+		return core.ErrProjectNotFound
+	}
+
+	for _, task := range r.tasks {
+		if task.ProjectID() == id {
+			return core.ErrProjectNotEmpty
+		}
+	}
+
+	seq := r.projectSeqs[id]
+	delete(r.projects, id)
+	delete(r.projectSeqs, id)
+
+	err := r.save()
+	if err != nil {
+		r.projects[id] = stored
+		r.projectSeqs[id] = seq
+
+		return err
+	}
+
+	return nil
+}
+
+// nextTaskKey 프로젝트에서 다음 번호를 배정하여 Task 키를 만듭니다. 프로젝트가 지정되지 않으면 빈 키를 반환합니다.
+// 쓰기 락을 잡은 상태에서 호출해야 합니다.
+func (r *Repository) nextTaskKey(id domain.ProjectID) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	project, exists := r.projects[id]
+	if !exists {
+		return "", core.ErrProjectNotFound
+	}
+
+	r.projectSeqs[id]++
+
+	return project.TaskKey(r.projectSeqs[id]), nil
+}
@@ -0,0 +1,47 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+var _ core.RevisionRepository = (*Repository)(nil)
+
+// ListRevisions implements core.RevisionRepository.
+func (r *Repository) ListRevisions(ctx context.Context, id domain.TaskID) ([]*domain.Task, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revisions := r.revisions[id]
+
+	ret := make([]*domain.Task, len(revisions))
+	for i, revision := range revisions {
+		ret[i] = revision.Clone()
+	}
+
+	return ret, nil
+}
+
+// GetRevision implements core.RevisionRepository.
+func (r *Repository) GetRevision(ctx context.Context, id domain.TaskID, version int) (*domain.Task, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, revision := range r.revisions[id] {
+		if revision.Version() == version {
+			return revision.Clone(), nil
+		}
+	}
+
+	return nil, core.ErrRevisionNotFound
+}
@@ -0,0 +1,541 @@
+package fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+type snapshot struct {
+	Tasks []snapshotTask `json:"tasks"`
+	Tags  []snapshotTag  `json:"tags"`
+	// Dependencies 각 항목은 [Task, DependsOn] 쌍입니다.
+	Dependencies [][2]string          `json:"dependencies,omitempty"`
+	AuditEntries []snapshotAuditEntry `json:"audit_entries,omitempty"`
+	Revisions    []snapshotRevision   `json:"revisions,omitempty"`
+	Comments     []snapshotComment    `json:"comments,omitempty"`
+	Attachments  []snapshotAttachment `json:"attachments,omitempty"`
+	Projects     []snapshotProject    `json:"projects,omitempty"`
+}
+
+type snapshotProject struct {
+	ID              string                `json:"id"`
+	Key             string                `json:"key"`
+	Name            string                `json:"name"`
+	Description     string                `json:"description"`
+	DefaultPriority string                `json:"default_priority"`
+	TaskSeq         int                   `json:"task_seq"`
+	Columns         []snapshotBoardColumn `json:"columns,omitempty"`
+	Version         int                   `json:"version"`
+	CreatedAt       time.Time             `json:"created_at"`
+	CreatedBy       string                `json:"created_by"`
+	UpdatedAt       time.Time             `json:"updated_at"`
+	UpdatedBy       string                `json:"updated_by"`
+}
+
+type snapshotBoardColumn struct {
+	Key      string   `json:"key"`
+	Name     string   `json:"name"`
+	Statuses []string `json:"statuses"`
+}
+
+type snapshotAttachment struct {
+	ID          string    `json:"id"`
+	TaskID      string    `json:"task_id"`
+	Name        string    `json:"name"`
+	BlobKey     string    `json:"blob_key"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"`
+	CreatedAt   time.Time `json:"created_at"`
+	CreatedBy   string    `json:"created_by"`
+}
+
+type snapshotComment struct {
+	ID        string    `json:"id"`
+	TaskID    string    `json:"task_id"`
+	ParentID  string    `json:"parent_id,omitempty"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy string    `json:"updated_by"`
+}
+
+// snapshotRevision 태그는 이후에 바뀌거나 삭제되어도 리비전에는 저장 당시의 값이 남도록 ID 대신 전체를 저장합니다.
+type snapshotRevision struct {
+	snapshotTask
+
+	Tags []snapshotTag `json:"tags,omitempty"`
+}
+
+type snapshotAuditEntry struct {
+	ID      string                `json:"id"`
+	TaskID  string                `json:"task_id"`
+	Action  string                `json:"action"`
+	At      time.Time             `json:"at"`
+	By      string                `json:"by"`
+	Changes []snapshotFieldChange `json:"changes,omitempty"`
+}
+
+type snapshotFieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type snapshotTag struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type snapshotTask struct {
+	ID          string                  `json:"id"`
+	Title       string                  `json:"title"`
+	Description string                  `json:"description"`
+	Status      string                  `json:"status"`
+	Priority    string                  `json:"priority"`
+	DueDate     *time.Time              `json:"due_date,omitempty"`
+	ParentID    string                  `json:"parent_id,omitempty"`
+	ProjectID   string                  `json:"project_id,omitempty"`
+	Key         string                  `json:"key,omitempty"`
+	Rank        string                  `json:"rank,omitempty"`
+	TagIDs      []string                `json:"tag_ids,omitempty"`
+	Checklist   []snapshotChecklistItem `json:"checklist,omitempty"`
+	Version     int                     `json:"version"`
+	CreatedAt   time.Time               `json:"created_at"`
+	CreatedBy   string                  `json:"created_by"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+	UpdatedBy   string                  `json:"updated_by"`
+	DeletedAt   *time.Time              `json:"deleted_at,omitempty"`
+}
+
+type snapshotChecklistItem struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+// load 스냅샷 파일에서 상태를 읽습니다. 파일이 없으면 빈 상태로 시작합니다.
+func (r *Repository) load() error {
+	data, err := os.ReadFile(r.snapshotPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	var snap snapshot
+
+	err = json.Unmarshal(data, &snap)
+	if err != nil {
+		return fmt.Errorf("failed to decode snapshot %s: %w", r.snapshotPath, err)
+	}
+
+	for _, tag := range snap.Tags {
+		r.tags[tag.ID] = domain.NewTag(domain.TagID(tag.ID), tag.Name, tag.Color)
+	}
+
+	for _, dep := range snap.Dependencies {
+		r.deps[domain.NewDependency(domain.TaskID(dep[0]), domain.TaskID(dep[1]))] = struct{}{}
+	}
+
+	r.audit = snap.auditEntries()
+
+	for _, comment := range snap.Comments {
+		r.comments[domain.CommentID(comment.ID)] = comment.toDomain()
+	}
+
+	for _, attachment := range snap.Attachments {
+		r.attachments[domain.AttachmentID(attachment.ID)] = attachment.toDomain()
+	}
+
+	for _, project := range snap.Projects {
+		r.projects[domain.ProjectID(project.ID)] = project.toDomain()
+		r.projectSeqs[domain.ProjectID(project.ID)] = project.TaskSeq
+	}
+
+	err = r.loadTasks(&snap)
+	if err != nil {
+		return fmt.Errorf("failed to decode snapshot %s: %w", r.snapshotPath, err)
+	}
+
+	return nil
+}
+
+// loadTasks 스냅샷의 Task와 리비전을 읽습니다. 태그는 먼저 읽혀 있어야 합니다.
+func (r *Repository) loadTasks(snap *snapshot) error {
+	for _, task := range snap.Tasks {
+		tags := make([]*domain.Tag, 0, len(task.TagIDs))
+		for _, id := range task.TagIDs {
+			tag, exists := r.tags[id]
+			if !exists {
+				return fmt.Errorf("task %s: %w", task.ID, core.ErrTagNotFound)
+			}
+
+			tags = append(tags, tag)
+		}
+
+		loaded, err := task.toDomain(tags)
+		if err != nil {
+			return err
+		}
+
+		r.tasks[task.ID] = loaded
+	}
+
+	// 리비전이 도입되기 전의 스냅샷에는 리비전이 없습니다.
+	for _, revision := range snap.Revisions {
+		tags := make([]*domain.Tag, len(revision.Tags))
+		for i, tag := range revision.Tags {
+			tags[i] = domain.NewTag(domain.TagID(tag.ID), tag.Name, tag.Color)
+		}
+
+		loaded, err := revision.toDomain(tags)
+		if err != nil {
+			return err
+		}
+
+		r.revisions[loaded.ID()] = append(r.revisions[loaded.ID()], loaded)
+	}
+
+	return nil
+}
+
+// save 현재 상태를 스냅샷 파일에 기록합니다. 쓰기 락을 잡은 상태에서 호출해야 합니다.
+// 임시 파일에 쓴 뒤 rename하여 중간에 실패해도 이전 스냅샷이 손상되지 않습니다.
+func (r *Repository) save() error {
+	if r.snapshotPath == "" {
+		return nil
+	}
+
+	snap := r.snapshot()
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(r.snapshotPath), filepath.Base(r.snapshotPath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot: %w", err)
+	}
+
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	closeErr := tmp.Close()
+
+	err = errors.Join(err, closeErr)
+	if err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), r.snapshotPath)
+	if err != nil {
+		return fmt.Errorf("failed to replace snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// snapshot 현재 상태를 스냅샷 형식으로 변환합니다.
+func (r *Repository) snapshot() *snapshot {
+	snap := &snapshot{
+		Tasks:        make([]snapshotTask, 0, len(r.tasks)),
+		Tags:         make([]snapshotTag, 0, len(r.tags)),
+		Dependencies: make([][2]string, 0, len(r.deps)),
+		AuditEntries: make([]snapshotAuditEntry, len(r.audit)),
+		Revisions:    nil,
+		Comments:     make([]snapshotComment, 0, len(r.comments)),
+		Attachments:  make([]snapshotAttachment, 0, len(r.attachments)),
+		Projects:     r.snapshotProjects(),
+	}
+
+	for _, attachment := range r.attachments {
+		snap.Attachments = append(snap.Attachments, newSnapshotAttachment(attachment))
+	}
+
+	for _, comment := range r.comments {
+		snap.Comments = append(snap.Comments, newSnapshotComment(comment))
+	}
+
+	for _, revisions := range r.revisions {
+		for _, revision := range revisions {
+			snap.Revisions = append(snap.Revisions, newSnapshotRevision(revision))
+		}
+	}
+
+	for i, entry := range r.audit {
+		snap.AuditEntries[i] = newSnapshotAuditEntry(entry)
+	}
+
+	for dep := range r.deps {
+		snap.Dependencies = append(snap.Dependencies, [2]string{string(dep.Task()), string(dep.DependsOn())})
+	}
+
+	for _, tag := range r.tags {
+		snap.Tags = append(snap.Tags, snapshotTag{ID: string(tag.ID()), Name: tag.Name(), Color: tag.Color()})
+	}
+
+	for _, task := range r.tasks {
+		tagIDs := make([]string, 0, len(task.Tags()))
+		for _, tag := range task.Tags() {
+			tagIDs = append(tagIDs, string(tag.ID()))
+		}
+
+		snapTask := newSnapshotTask(task)
+		snapTask.TagIDs = tagIDs
+		snap.Tasks = append(snap.Tasks, snapTask)
+	}
+
+	return snap
+}
+
+func (r *Repository) snapshotProjects() []snapshotProject {
+	projects := make([]snapshotProject, 0, len(r.projects))
+	for _, project := range r.projects {
+		projects = append(projects, newSnapshotProject(project, r.projectSeqs[project.ID()]))
+	}
+
+	return projects
+}
+
+// newSnapshotTask 태그를 제외한 Task의 필드를 스냅샷 형식으로 변환합니다.
+func newSnapshotTask(task *domain.Task) snapshotTask {
+	checklist := make([]snapshotChecklistItem, len(task.Checklist()))
+	for i, item := range task.Checklist() {
+		checklist[i] = snapshotChecklistItem{ID: string(item.ID()), Text: item.Text(), Done: item.Done()}
+	}
+
+	return snapshotTask{
+		ID:          string(task.ID()),
+		Title:       task.Title(),
+		Description: task.Description(),
+		Status:      string(task.Status()),
+		Priority:    string(task.Priority()),
+		DueDate:     task.DueDate(),
+		ParentID:    string(task.ParentID()),
+		ProjectID:   string(task.ProjectID()),
+		Key:         task.Key(),
+		Rank:        task.Rank(),
+		TagIDs:      nil,
+		Checklist:   checklist,
+		Version:     task.Version(),
+		CreatedAt:   task.Created().At(),
+		CreatedBy:   task.Created().By(),
+		UpdatedAt:   task.Updated().At(),
+		UpdatedBy:   task.Updated().By(),
+		DeletedAt:   task.DeletedAt(),
+	}
+}
+
+func newSnapshotRevision(revision *domain.Task) snapshotRevision {
+	tags := make([]snapshotTag, len(revision.Tags()))
+	for i, tag := range revision.Tags() {
+		tags[i] = snapshotTag{ID: string(tag.ID()), Name: tag.Name(), Color: tag.Color()}
+	}
+
+	return snapshotRevision{snapshotTask: newSnapshotTask(revision), Tags: tags}
+}
+
+func (t *snapshotTask) toDomain(tags []*domain.Tag) (*domain.Task, error) {
+	// 우선순위가 도입되기 전의 스냅샷은 기본 우선순위로 읽습니다.
+	priority, err := domain.ParseTaskPriority(t.Priority)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var checklist []domain.ChecklistItem
+
+	for _, item := range t.Checklist {
+		checklist = append(checklist,
+			domain.NewChecklistItem(domain.ChecklistItemID(item.ID), item.Text, item.Done))
+	}
+
+	return domain.NewTask(domain.TaskParams{
+		ID:          domain.TaskID(t.ID),
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      domain.TaskStatus(t.Status),
+		Priority:    priority,
+		DueDate:     t.DueDate,
+		ParentID:    domain.TaskID(t.ParentID),
+		ProjectID:   domain.ProjectID(t.ProjectID),
+		Key:         t.Key,
+		Rank:        t.Rank,
+		Tags:        tags,
+		Checklist:   checklist,
+		Version:     t.Version,
+		Created:     domain.NewStamp(t.CreatedAt, t.CreatedBy),
+		Updated:     domain.NewStamp(t.UpdatedAt, t.UpdatedBy),
+		DeletedAt:   t.DeletedAt,
+	}), nil
+}
+
+func newSnapshotAuditEntry(entry domain.AuditEntry) snapshotAuditEntry {
+	entryChanges := entry.Changes()
+
+	changes := make([]snapshotFieldChange, len(entryChanges))
+	for i, change := range entryChanges {
+		changes[i] = snapshotFieldChange{Field: change.Field(), Before: change.Before(), After: change.After()}
+	}
+
+	return snapshotAuditEntry{
+		ID:      string(entry.ID()),
+		TaskID:  string(entry.TaskID()),
+		Action:  string(entry.Action()),
+		At:      entry.Stamp().At(),
+		By:      entry.Stamp().By(),
+		Changes: changes,
+	}
+}
+
+func (s *snapshot) auditEntries() []domain.AuditEntry {
+	var entries []domain.AuditEntry
+
+	for _, entry := range s.AuditEntries {
+		entries = append(entries, entry.toDomain())
+	}
+
+	return entries
+}
+
+func (e *snapshotAuditEntry) toDomain() domain.AuditEntry {
+	var changes []domain.FieldChange
+
+	for _, change := range e.Changes {
+		changes = append(changes, domain.NewFieldChange(change.Field, change.Before, change.After))
+	}
+
+	return domain.RestoreAuditEntry(domain.AuditEntryParams{
+		ID:      domain.AuditEntryID(e.ID),
+		TaskID:  domain.TaskID(e.TaskID),
+		Action:  domain.AuditAction(e.Action),
+		Stamp:   domain.NewStamp(e.At, e.By),
+		Changes: changes,
+	})
+}
+
+func newSnapshotComment(comment *domain.Comment) snapshotComment {
+	return snapshotComment{
+		ID:        string(comment.ID()),
+		TaskID:    string(comment.TaskID()),
+		ParentID:  string(comment.ParentID()),
+		Body:      comment.Body(),
+		CreatedAt: comment.Created().At(),
+		CreatedBy: comment.Created().By(),
+		UpdatedAt: comment.Updated().At(),
+		UpdatedBy: comment.Updated().By(),
+	}
+}
+
+func (c *snapshotComment) toDomain() *domain.Comment {
+	return domain.NewComment(domain.CommentParams{
+		ID:       domain.CommentID(c.ID),
+		TaskID:   domain.TaskID(c.TaskID),
+		ParentID: domain.CommentID(c.ParentID),
+		Body:     c.Body,
+		Created:  domain.NewStamp(c.CreatedAt, c.CreatedBy),
+		Updated:  domain.NewStamp(c.UpdatedAt, c.UpdatedBy),
+	})
+}
+
+func newSnapshotAttachment(attachment *domain.Attachment) snapshotAttachment {
+	blob := attachment.Blob()
+
+	return snapshotAttachment{
+		ID:          string(attachment.ID()),
+		TaskID:      string(attachment.TaskID()),
+		Name:        attachment.Name(),
+		BlobKey:     blob.Key,
+		ContentType: blob.ContentType,
+		Size:        blob.Size,
+		Checksum:    blob.Checksum,
+		CreatedAt:   attachment.Created().At(),
+		CreatedBy:   attachment.Created().By(),
+	}
+}
+
+func (a *snapshotAttachment) toDomain() *domain.Attachment {
+	return domain.NewAttachment(domain.AttachmentParams{
+		ID:     domain.AttachmentID(a.ID),
+		TaskID: domain.TaskID(a.TaskID),
+		Name:   a.Name,
+		Blob: domain.AttachmentBlob{
+			Key:         a.BlobKey,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			Checksum:    a.Checksum,
+		},
+		Created: domain.NewStamp(a.CreatedAt, a.CreatedBy),
+	})
+}
+
+func newSnapshotProject(project *domain.Project, taskSeq int) snapshotProject {
+	columns := make([]snapshotBoardColumn, len(project.Columns()))
+
+	for index, column := range project.Columns() {
+		statuses := make([]string, len(column.Statuses()))
+		for i, status := range column.Statuses() {
+			statuses[i] = string(status)
+		}
+
+		columns[index] = snapshotBoardColumn{Key: column.Key(), Name: column.Name(), Statuses: statuses}
+	}
+
+	return snapshotProject{
+		ID:              string(project.ID()),
+		Key:             string(project.Key()),
+		Name:            project.Name(),
+		Description:     project.Description(),
+		DefaultPriority: string(project.DefaultPriority()),
+		TaskSeq:         taskSeq,
+		Columns:         columns,
+		Version:         project.Version(),
+		CreatedAt:       project.Created().At(),
+		CreatedBy:       project.Created().By(),
+		UpdatedAt:       project.Updated().At(),
+		UpdatedBy:       project.Updated().By(),
+	}
+}
+
+func (p *snapshotProject) toDomain() *domain.Project {
+	var columns []domain.BoardColumn
+
+	for _, column := range p.Columns {
+		statuses := make([]domain.TaskStatus, len(column.Statuses))
+		for i, status := range column.Statuses {
+			statuses[i] = domain.TaskStatus(status)
+		}
+
+		columns = append(columns, domain.NewBoardColumn(column.Key, column.Name, statuses))
+	}
+
+	// 버전이 생기기 전에 저장된 스냅샷의 프로젝트는 한 번도 수정되지 않은 것으로 봅니다.
+	return domain.NewProject(domain.ProjectParams{
+		ID:              domain.ProjectID(p.ID),
+		Key:             domain.ProjectKey(p.Key),
+		Name:            p.Name,
+		Description:     p.Description,
+		DefaultPriority: domain.TaskPriority(p.DefaultPriority),
+		Columns:         columns,
+		Version:         max(p.Version, 1),
+		Created:         domain.NewStamp(p.CreatedAt, p.CreatedBy),
+		Updated:         domain.NewStamp(p.UpdatedAt, p.UpdatedBy),
+	})
+}
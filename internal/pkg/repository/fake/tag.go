@@ -0,0 +1,179 @@
+package fake
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+)
+
+// CreateTag implements core.TagRepository.
+func (r *Repository) CreateTag(ctx context.Context, spec *domain.TagSpec) (*domain.Tag, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.hasTagName(spec.Name(), "") {
+		return nil, core.ErrDuplicateTag
+	}
+
+	tag := domain.NewTag(domain.TagID(ulid.Make().String()), spec.Name(), spec.Color())
+	r.tags[string(tag.ID())] = tag
+
+	err := r.save()
+	if err != nil {
+		delete(r.tags, string(tag.ID()))
+
+		return nil, err
+	}
+
+	return tag.Clone(), nil
+}
+
+// ListTags implements core.TagRepository.
+func (r *Repository) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tags := make([]*domain.Tag, 0, len(r.tags))
+	for _, tag := range r.tags {
+		tags = append(tags, tag.Clone())
+	}
+
+	slices.SortFunc(tags, func(a, b *domain.Tag) int {
+		return cmp.Compare(a.Name(), b.Name())
+	})
+
+	return tags, nil
+}
+
+// GetTag implements core.TagRepository.
+func (r *Repository) GetTag(ctx context.Context, id domain.TagID) (*domain.Tag, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tag, exists := r.tags[string(id)]
+	if !exists {
+		return nil, core.ErrTagNotFound
+	}
+
+	return tag.Clone(), nil
+}
+
+// UpdateTag implements core.TagRepository.
+// 태그가 붙은 Task가 가진 태그 정보도 함께 바꿉니다.
+func (r *Repository) UpdateTag(ctx context.Context, tag *domain.Tag, stamp domain.Stamp) (*domain.Tag, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.tags[string(tag.ID())]
+	if !exists {
+		return nil, core.ErrTagNotFound
+	}
+
+	if r.hasTagName(tag.Name(), tag.ID()) {
+		return nil, core.ErrDuplicateTag
+	}
+
+	tasks, revisions, audited := maps.Clone(r.tasks), maps.Clone(r.revisions), len(r.audit)
+	r.tags[string(tag.ID())] = tag.Clone()
+
+	r.retagTasks(tag.ID(), stamp, func(task *domain.Task) *domain.Task {
+		return task.AddTag(tag)
+	})
+
+	err := r.save()
+	if err != nil {
+		r.tags[string(tag.ID())] = stored
+		r.tasks, r.revisions, r.audit = tasks, revisions, r.audit[:audited]
+
+		return nil, err
+	}
+
+	return tag.Clone(), nil
+}
+
+// DeleteTag implements core.TagRepository.
+func (r *Repository) DeleteTag(ctx context.Context, id domain.TagID, stamp domain.Stamp) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.tags[string(id)]
+	if !exists {
+		return core.ErrTagNotFound
+	}
+
+	tasks, revisions, audited := maps.Clone(r.tasks), maps.Clone(r.revisions), len(r.audit)
+	delete(r.tags, string(id))
+
+	r.retagTasks(id, stamp, func(task *domain.Task) *domain.Task {
+		return task.RemoveTag(id)
+	})
+
+	err := r.save()
+	if err != nil {
+		r.tags[string(id)] = stored
+		r.tasks, r.revisions, r.audit = tasks, revisions, r.audit[:audited]
+
+		return err
+	}
+
+	return nil
+}
+
+// retagTasks 태그 id가 붙은 Task를 change로 바꿉니다. 휴지통에 있지 않은 Task는 stamp로 수정한 것으로 보아
+// 버전을 올리고 리비전과 감사 기록을 남깁니다. 락을 잡은 상태에서 호출해야 합니다.
+func (r *Repository) retagTasks(id domain.TagID, stamp domain.Stamp, change func(*domain.Task) *domain.Task) {
+	// 감사 기록이 항상 같은 순서로 남도록 ID 순서로 바꿉니다.
+	for _, taskID := range slices.Sorted(maps.Keys(r.tasks)) {
+		task := r.tasks[taskID]
+		if !task.HasTag(id) {
+			continue
+		}
+
+		if task.DeletedAt() != nil {
+			r.tasks[taskID] = change(task)
+
+			continue
+		}
+
+		updated := change(task).Touch(stamp).WithVersion(task.Version() + 1)
+		r.tasks[taskID] = updated
+		r.revisions[task.ID()] = append(r.revisions[task.ID()], updated)
+		r.appendAudit(domain.NewAuditEntry(domain.AuditActionUpdate, task, updated, stamp))
+	}
+}
+
+// hasTagName except를 제외한 다른 태그가 name을 쓰고 있는지 확인합니다.
+func (r *Repository) hasTagName(name string, except domain.TagID) bool {
+	for _, tag := range r.tags {
+		if tag.Name() == name && tag.ID() != except {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,156 @@
+package fake
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+)
+
+var _ core.TrashRepository = (*Repository)(nil)
+
+// RestoreTask implements core.TrashRepository.
+func (r *Repository) RestoreTask(ctx context.Context, id domain.TaskID, stamp domain.Stamp) (*domain.Task, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task, exists := r.tasks[string(id)]
+	if !exists || task.DeletedAt() == nil {
+		return nil, core.ErrTaskNotFound
+	}
+
+	restored := task.WithDeletedAt(nil)
+	r.tasks[string(id)] = restored
+	audited := r.appendAudit(domain.NewAuditEntry(domain.AuditActionRestore, task, restored, stamp))
+
+	err := r.save()
+	if err != nil {
+		r.tasks[string(id)] = task
+		r.audit = r.audit[:audited]
+
+		return nil, err
+	}
+
+	return restored.Clone(), nil
+}
+
+// PurgeTask implements core.TrashRepository.
+func (r *Repository) PurgeTask(ctx context.Context, id domain.TaskID, stamp domain.Stamp) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task, exists := r.tasks[string(id)]
+	if !exists || task.DeletedAt() == nil {
+		return core.ErrTaskNotFound
+	}
+
+	return r.purge([]domain.TaskID{id}, stamp)
+}
+
+// PurgeDeletedTasks implements core.TrashRepository.
+func (r *Repository) PurgeDeletedTasks(
+	ctx context.Context,
+	before time.Time,
+	stamp domain.Stamp,
+) ([]domain.TaskID, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ids []domain.TaskID
+
+	for _, task := range r.tasks {
+		if deletedAt := task.DeletedAt(); deletedAt != nil && deletedAt.Before(before) {
+			ids = append(ids, task.ID())
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	slices.Sort(ids)
+
+	err := r.purge(ids, stamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// purge Task와 Task의 리비전과 댓글, Task가 양 끝 중 하나인 의존성을 삭제하고 stamp로 감사 기록을 남깁니다.
+// 삭제한 Task의 하위 Task는 최상위 Task로 만듭니다. 쓰기 락을 잡은 상태에서 호출해야 합니다.
+func (r *Repository) purge(ids []domain.TaskID, stamp domain.Stamp) error {
+	tasks := maps.Clone(r.tasks)
+	deps := maps.Clone(r.deps)
+	revisions := maps.Clone(r.revisions)
+	comments := maps.Clone(r.comments)
+	audited := len(r.audit)
+
+	for _, id := range ids {
+		delete(r.tasks, string(id))
+		delete(r.revisions, id)
+		r.appendAudit(domain.NewPurgeAuditEntry(id, stamp))
+	}
+
+	maps.DeleteFunc(r.comments, func(_ domain.CommentID, comment *domain.Comment) bool {
+		return slices.Contains(ids, comment.TaskID())
+	})
+
+	maps.DeleteFunc(r.deps, func(dep domain.Dependency, _ struct{}) bool {
+		return slices.Contains(ids, dep.Task()) || slices.Contains(ids, dep.DependsOn())
+	})
+
+	r.orphanTasks(ids, stamp)
+
+	err := r.save()
+	if err != nil {
+		r.tasks = tasks
+		r.deps = deps
+		r.revisions = revisions
+		r.comments = comments
+		r.audit = r.audit[:audited]
+
+		return err
+	}
+
+	return nil
+}
+
+// orphanTasks 상위 Task가 ids 중 하나인 Task를 최상위 Task로 만듭니다. 휴지통에 있지 않은 Task는 stamp로 수정한
+// 것으로 보아 버전을 올리고 리비전과 감사 기록을 남깁니다. 쓰기 락을 잡은 상태에서 호출해야 합니다.
+func (r *Repository) orphanTasks(ids []domain.TaskID, stamp domain.Stamp) {
+	// 감사 기록이 항상 같은 순서로 남도록 ID 순서로 바꿉니다.
+	for _, taskID := range slices.Sorted(maps.Keys(r.tasks)) {
+		task := r.tasks[taskID]
+		if !slices.Contains(ids, task.ParentID()) {
+			continue
+		}
+
+		if task.DeletedAt() != nil {
+			r.tasks[taskID] = task.WithParent("")
+
+			continue
+		}
+
+		updated := task.WithParent("").Touch(stamp).WithVersion(task.Version() + 1)
+		r.tasks[taskID] = updated
+		r.revisions[task.ID()] = append(r.revisions[task.ID()], updated)
+		r.appendAudit(domain.NewAuditEntry(domain.AuditActionUpdate, task, updated, stamp))
+	}
+}
@@ -0,0 +1,142 @@
+package orm
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+var _ core.AttachmentRepository = (*Repository)(nil)
+
+// AttachmentModel 첨부 파일의 메타데이터입니다. 본문은 BlobKey로 blob 저장소에서 읽습니다.
+type AttachmentModel struct {
+	ID          string `gorm:"primaryKey"`
+	TaskID      string `gorm:"not null;index"`
+	Name        string `gorm:"not null"`
+	BlobKey     string `gorm:"not null"`
+	ContentType string `gorm:"not null"`
+	Size        int64  `gorm:"not null"`
+	Checksum    string `gorm:"not null"`
+	// 시각은 flow.Service의 clock으로 정하므로 GORM의 자동 기록을 끕니다.
+	CreatedAt time.Time `gorm:"autoCreateTime:false"`
+	CreatedBy string
+}
+
+func (AttachmentModel) TableName() string {
+	return "attachments"
+}
+
+func toDomainAttachment(model *AttachmentModel) *domain.Attachment {
+	return domain.NewAttachment(domain.AttachmentParams{
+		ID:     domain.AttachmentID(model.ID),
+		TaskID: domain.TaskID(model.TaskID),
+		Name:   model.Name,
+		Blob: domain.AttachmentBlob{
+			Key:         model.BlobKey,
+			ContentType: model.ContentType,
+			Size:        model.Size,
+			Checksum:    model.Checksum,
+		},
+		Created: domain.NewStamp(model.CreatedAt, model.CreatedBy),
+	})
+}
+
+func (r *Repository) CreateAttachment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	spec *domain.AttachmentSpec,
+	blob domain.AttachmentBlob,
+	stamp domain.Stamp,
+) (*domain.Attachment, error) {
+	model := AttachmentModel{
+		ID:          ulid.Make().String(),
+		TaskID:      string(taskID),
+		Name:        spec.Name(),
+		BlobKey:     blob.Key,
+		ContentType: blob.ContentType,
+		Size:        blob.Size,
+		Checksum:    blob.Checksum,
+		CreatedAt:   stamp.At(),
+		CreatedBy:   stamp.By(),
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Select("id").First(&TaskModel{}, "id = ?", string(taskID)).Error //nolint:exhaustruct
+		if err != nil {
+			return translateNotFound(err, core.ErrTaskNotFound)
+		}
+
+		return tx.Create(&model).Error
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return toDomainAttachment(&model), nil
+}
+
+func (r *Repository) ListAttachments(ctx context.Context, taskID domain.TaskID) ([]*domain.Attachment, error) {
+	var models []AttachmentModel
+
+	err := r.db.WithContext(ctx).Where("task_id = ?", string(taskID)).Order("id").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]*domain.Attachment, len(models))
+	for i := range models {
+		attachments[i] = toDomainAttachment(&models[i])
+	}
+
+	return attachments, nil
+}
+
+// ListPurgedAttachments Task 행이 없는 첨부 파일을 찾습니다. 휴지통에 있는 Task는 deleted_at만 채워진 행으로
+// 남아 있으므로 제외됩니다.
+func (r *Repository) ListPurgedAttachments(ctx context.Context, limit int) ([]*domain.Attachment, error) {
+	var models []AttachmentModel
+
+	err := r.db.WithContext(ctx).
+		Where("NOT EXISTS (SELECT 1 FROM tasks WHERE tasks.id = attachments.task_id)").
+		Order("id").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]*domain.Attachment, len(models))
+	for i := range models {
+		attachments[i] = toDomainAttachment(&models[i])
+	}
+
+	return attachments, nil
+}
+
+func (r *Repository) GetAttachment(ctx context.Context, id domain.AttachmentID) (*domain.Attachment, error) {
+	var model AttachmentModel
+
+	err := r.db.WithContext(ctx).First(&model, "id = ?", string(id)).Error
+	if err != nil {
+		return nil, translateNotFound(err, core.ErrAttachmentNotFound)
+	}
+
+	return toDomainAttachment(&model), nil
+}
+
+func (r *Repository) DeleteAttachment(ctx context.Context, id domain.AttachmentID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", string(id)).Delete(&AttachmentModel{}) //nolint:exhaustruct
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return core.ErrAttachmentNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package orm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+var _ core.AuditRepository = (*Repository)(nil)
+
+// AuditEntryModel 감사 기록입니다. Task를 영구 삭제해도 남도록 tasks 테이블을 참조하지 않습니다.
+type AuditEntryModel struct {
+	ID     string `gorm:"primaryKey"`
+	TaskID string `gorm:"not null;index"`
+	Action string `gorm:"not null"`
+	// 시각은 flow.Service의 clock으로 정하므로 GORM의 자동 기록을 끕니다.
+	RecordedAt time.Time `gorm:"autoCreateTime:false;index"`
+	Actor      string    `gorm:"index"`
+	// Changes []auditChange를 JSON으로 저장합니다.
+	Changes string `gorm:"not null;default:'[]'"`
+}
+
+func (AuditEntryModel) TableName() string {
+	return "audit_entries"
+}
+
+type auditChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+func newAuditEntryModel(entry *domain.AuditEntry) (*AuditEntryModel, error) {
+	entryChanges := entry.Changes()
+
+	changes := make([]auditChange, len(entryChanges))
+	for i, change := range entryChanges {
+		changes[i] = auditChange{Field: change.Field(), Before: change.Before(), After: change.After()}
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit changes: %w", err)
+	}
+
+	return &AuditEntryModel{
+		ID:         string(entry.ID()),
+		TaskID:     string(entry.TaskID()),
+		Action:     string(entry.Action()),
+		RecordedAt: entry.Stamp().At(),
+		Actor:      entry.Stamp().By(),
+		Changes:    string(data),
+	}, nil
+}
+
+func toDomainAuditEntry(model *AuditEntryModel) (domain.AuditEntry, error) {
+	var changes []auditChange
+
+	err := json.Unmarshal([]byte(model.Changes), &changes)
+	if err != nil {
+		return domain.AuditEntry{}, fmt.Errorf("failed to decode audit changes of %s: %w", model.ID, err)
+	}
+
+	var domainChanges []domain.FieldChange
+
+	for _, change := range changes {
+		domainChanges = append(domainChanges, domain.NewFieldChange(change.Field, change.Before, change.After))
+	}
+
+	return domain.RestoreAuditEntry(domain.AuditEntryParams{
+		ID:      domain.AuditEntryID(model.ID),
+		TaskID:  domain.TaskID(model.TaskID),
+		Action:  domain.AuditAction(model.Action),
+		Stamp:   domain.NewStamp(model.RecordedAt, model.Actor),
+		Changes: domainChanges,
+	}), nil
+}
+
+// appendAudit entries에 새 ID를 부여하여 tx 안에서 저장합니다. ID는 만든 순서대로 커지지만 커밋 순서와는 다를 수
+// 있습니다. 먼저 ID를 받은 트랜잭션이 더 늦게 커밋되면 그 기록은 이미 커서가 지나간 자리에 나타납니다.
+func appendAudit(tx *gorm.DB, entries ...domain.AuditEntry) error {
+	for _, entry := range entries {
+		entry = entry.WithID(domain.AuditEntryID(ulid.Make().String()))
+
+		model, err := newAuditEntryModel(&entry)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(model).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) ListAuditEntries(ctx context.Context, query *core.AuditQuery) (*core.AuditPage, error) {
+	tx := r.db.WithContext(ctx).Where("id > ?", string(query.After))
+
+	if query.TaskID != "" {
+		tx = tx.Where("task_id = ?", string(query.TaskID))
+	}
+
+	if query.Actor != "" {
+		tx = tx.Where("actor = ?", query.Actor)
+	}
+
+	if query.Since != nil {
+		tx = tx.Where("recorded_at >= ?", *query.Since)
+	}
+
+	if query.Until != nil {
+		tx = tx.Where("recorded_at < ?", *query.Until)
+	}
+
+	var models []AuditEntryModel
+
+	err := tx.Order("id").Limit(query.Limit + 1).Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.AuditEntry, len(models))
+	for i := range models {
+		entries[i], err = toDomainAuditEntry(&models[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return core.NewAuditPage(entries, query.Limit), nil
+}
@@ -0,0 +1,95 @@
+package orm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+// BoardColumnsColumn 프로젝트 보드의 열을 순서대로 JSON 배열로 저장하는 컬럼입니다.
+// 빈 배열은 열을 설정하지 않은 프로젝트로, domain.DefaultBoardColumns를 씁니다.
+//
+//nolint:recvcheck // sql.Scanner는 포인터 수신자, driver.Valuer는 값 수신자여야 합니다.
+type BoardColumnsColumn []boardColumn
+
+type boardColumn struct {
+	Key      string   `json:"key"`
+	Name     string   `json:"name"`
+	Statuses []string `json:"statuses"`
+}
+
+func newBoardColumnsColumn(columns []domain.BoardColumn) BoardColumnsColumn {
+	ret := make(BoardColumnsColumn, len(columns))
+
+	for index, column := range columns {
+		statuses := make([]string, len(column.Statuses()))
+		for i, status := range column.Statuses() {
+			statuses[i] = string(status)
+		}
+
+		ret[index] = boardColumn{Key: column.Key(), Name: column.Name(), Statuses: statuses}
+	}
+
+	return ret
+}
+
+// GormDataType 드라이버와 관계없이 JSON 문자열을 text 컬럼에 저장합니다.
+func (BoardColumnsColumn) GormDataType() string {
+	return "text"
+}
+
+// Value implements driver.Valuer. 열이 없으면 빈 배열로 저장합니다.
+func (c BoardColumnsColumn) Value() (driver.Value, error) {
+	if c == nil {
+		c = BoardColumnsColumn{}
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode board columns: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner.
+func (c *BoardColumnsColumn) Scan(src any) error {
+	var data []byte
+
+	switch value := src.(type) {
+	case nil:
+		*c = nil
+
+		return nil
+	case string:
+		data = []byte(value)
+	case []byte:
+		data = value
+	default:
+		return fmt.Errorf("failed to decode board columns: %w: %T", errUnsupportedColumn, src)
+	}
+
+	err := json.Unmarshal(data, c)
+	if err != nil {
+		return fmt.Errorf("failed to decode board columns: %w", err)
+	}
+
+	return nil
+}
+
+func (c BoardColumnsColumn) toDomain() []domain.BoardColumn {
+	var ret []domain.BoardColumn
+
+	for _, column := range c {
+		statuses := make([]domain.TaskStatus, len(column.Statuses))
+		for i, status := range column.Statuses {
+			statuses[i] = domain.TaskStatus(status)
+		}
+
+		ret = append(ret, domain.NewBoardColumn(column.Key, column.Name, statuses))
+	}
+
+	return ret
+}
@@ -0,0 +1,88 @@
+package orm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+)
+
+var errUnsupportedColumn = errors.New("unsupported column value")
+
+// ChecklistColumn Task의 체크리스트 항목을 순서대로 JSON 배열로 저장하는 컬럼입니다.
+// 항목은 Task와 함께 읽고 쓰며 따로 조회하지 않으므로 별도 테이블을 두지 않습니다.
+//
+//nolint:recvcheck // sql.Scanner는 포인터 수신자, driver.Valuer는 값 수신자여야 합니다.
+type ChecklistColumn []checklistItem
+
+type checklistItem struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+func newChecklistColumn(items []domain.ChecklistItem) ChecklistColumn {
+	ret := make(ChecklistColumn, len(items))
+	for i, item := range items {
+		ret[i] = checklistItem{ID: string(item.ID()), Text: item.Text(), Done: item.Done()}
+	}
+
+	return ret
+}
+
+// GormDataType 드라이버와 관계없이 JSON 문자열을 text 컬럼에 저장합니다.
+func (ChecklistColumn) GormDataType() string {
+	return "text"
+}
+
+// Value implements driver.Valuer. 항목이 없으면 빈 배열로 저장합니다.
+func (c ChecklistColumn) Value() (driver.Value, error) {
+	if c == nil {
+		c = ChecklistColumn{}
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode checklist: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner.
+func (c *ChecklistColumn) Scan(src any) error {
+	var data []byte
+
+	switch value := src.(type) {
+	case nil:
+		*c = nil
+
+		return nil
+	case string:
+		data = []byte(value)
+	case []byte:
+		data = value
+	default:
+		return fmt.Errorf("failed to decode checklist: %w: %T", errUnsupportedColumn, src)
+	}
+
+	err := json.Unmarshal(data, c)
+	if err != nil {
+		return fmt.Errorf("failed to decode checklist: %w", err)
+	}
+
+	return nil
+}
+
+// toDomain 항목이 없으면 nil을 반환하여 저장소와 관계없이 같은 Task로 복원합니다.
+func (c ChecklistColumn) toDomain() []domain.ChecklistItem {
+	var ret []domain.ChecklistItem
+
+	for _, item := range c {
+		ret = append(ret, domain.NewChecklistItem(domain.ChecklistItemID(item.ID), item.Text, item.Done))
+	}
+
+	return ret
+}
@@ -0,0 +1,169 @@
+package orm
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+var _ core.CommentRepository = (*Repository)(nil)
+
+type CommentModel struct {
+	ID     string `gorm:"primaryKey"`
+	TaskID string `gorm:"not null;index"`
+	// ParentID 답글이 아니면 빈 문자열입니다.
+	ParentID string `gorm:"not null;default:'';index"`
+	Body     string `gorm:"not null"`
+	// 시각은 flow.Service의 clock으로 정하므로 GORM의 자동 기록을 끕니다.
+	CreatedAt time.Time `gorm:"autoCreateTime:false"`
+	CreatedBy string
+	UpdatedAt time.Time `gorm:"autoUpdateTime:false"`
+	UpdatedBy string
+}
+
+func (CommentModel) TableName() string {
+	return "comments"
+}
+
+func toDomainComment(model *CommentModel) *domain.Comment {
+	return domain.NewComment(domain.CommentParams{
+		ID:       domain.CommentID(model.ID),
+		TaskID:   domain.TaskID(model.TaskID),
+		ParentID: domain.CommentID(model.ParentID),
+		Body:     model.Body,
+		Created:  domain.NewStamp(model.CreatedAt, model.CreatedBy),
+		Updated:  domain.NewStamp(model.UpdatedAt, model.UpdatedBy),
+	})
+}
+
+func (r *Repository) CreateComment(
+	ctx context.Context,
+	taskID domain.TaskID,
+	parentID domain.CommentID,
+	spec *domain.CommentSpec,
+	stamp domain.Stamp,
+) (*domain.Comment, error) {
+	model := CommentModel{
+		ID:        ulid.Make().String(),
+		TaskID:    string(taskID),
+		ParentID:  string(parentID),
+		Body:      spec.Body(),
+		CreatedAt: stamp.At(),
+		CreatedBy: stamp.By(),
+		UpdatedAt: stamp.At(),
+		UpdatedBy: stamp.By(),
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Select("id").First(&TaskModel{}, "id = ?", string(taskID)).Error //nolint:exhaustruct
+		if err != nil {
+			return translateNotFound(err, core.ErrTaskNotFound)
+		}
+
+		if parentID != "" {
+			err = tx.Select("id").
+				First(&CommentModel{}, "id = ? AND task_id = ?", string(parentID), string(taskID)).Error //nolint:exhaustruct
+			if err != nil {
+				return translateNotFound(err, core.ErrCommentNotFound)
+			}
+		}
+
+		return tx.Create(&model).Error
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return toDomainComment(&model), nil
+}
+
+func (r *Repository) ListComments(ctx context.Context, taskID domain.TaskID) ([]*domain.Comment, error) {
+	var models []CommentModel
+
+	err := r.db.WithContext(ctx).Where("task_id = ?", string(taskID)).Order("id").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*domain.Comment, len(models))
+	for i := range models {
+		comments[i] = toDomainComment(&models[i])
+	}
+
+	return comments, nil
+}
+
+func (r *Repository) GetComment(ctx context.Context, id domain.CommentID) (*domain.Comment, error) {
+	var model CommentModel
+
+	err := r.db.WithContext(ctx).First(&model, "id = ?", string(id)).Error
+	if err != nil {
+		return nil, translateNotFound(err, core.ErrCommentNotFound)
+	}
+
+	return toDomainComment(&model), nil
+}
+
+func (r *Repository) UpdateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	var model CommentModel
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Model(&CommentModel{}). //nolint:exhaustruct
+			Where("id = ?", string(comment.ID())).
+			Updates(map[string]any{
+				"body":       comment.Body(),
+				"updated_at": comment.Updated().At(),
+				"updated_by": comment.Updated().By(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return core.ErrCommentNotFound
+		}
+
+		return tx.First(&model, "id = ?", string(comment.ID())).Error
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return toDomainComment(&model), nil
+}
+
+func (r *Repository) DeleteComment(ctx context.Context, id domain.CommentID) error {
+	//nolint:wrapcheck
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids := []string{string(id)}
+
+		// 한 단계씩 내려가며 모든 하위 답글의 ID를 모읍니다.
+		for frontier := ids; len(frontier) > 0; {
+			var replies []string
+
+			err := tx.Model(&CommentModel{}).Where("parent_id IN ?", frontier).Pluck("id", &replies).Error //nolint:exhaustruct
+			if err != nil {
+				return err
+			}
+
+			ids = append(ids, replies...)
+			frontier = replies
+		}
+
+		result := tx.Where("id IN ?", ids).Delete(&CommentModel{}) //nolint:exhaustruct
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return core.ErrCommentNotFound
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,175 @@
+package orm
+
+import (
+	"context"
+	"slices"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ core.DependencyRepository = (*Repository)(nil)
+
+// forUpdate 읽은 행을 트랜잭션이 끝날 때까지 잠급니다.
+//
+//nolint:exhaustruct
+var forUpdate = clause.Locking{Strength: clause.LockingStrengthUpdate}
+
+// DependencyModel TaskID인 Task가 DependsOnID인 Task에 의해 막혀 있다는 의존성입니다.
+type DependencyModel struct {
+	TaskID      string `gorm:"primaryKey"`
+	DependsOnID string `gorm:"primaryKey;index"`
+}
+
+func (DependencyModel) TableName() string {
+	return "task_dependencies"
+}
+
+func toDomainDependencies(models []DependencyModel) []domain.Dependency {
+	deps := make([]domain.Dependency, len(models))
+	for i, model := range models {
+		deps[i] = domain.NewDependency(domain.TaskID(model.TaskID), domain.TaskID(model.DependsOnID))
+	}
+
+	return deps
+}
+
+func (r *Repository) AddDependency(ctx context.Context, dependency domain.Dependency) error {
+	//nolint:wrapcheck
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked []string
+
+		ids := slices.Compact(slices.Sorted(slices.Values(
+			[]string{string(dependency.Task()), string(dependency.DependsOn())})))
+
+		err := tx.Clauses(forUpdate).
+			Model(&TaskModel{}). //nolint:exhaustruct
+			Where("id IN ?", ids).
+			Order("id").
+			Pluck("id", &locked).Error
+		if err != nil {
+			return err
+		}
+
+		if len(locked) != len(ids) {
+			return core.ErrTaskNotFound
+		}
+
+		reachable, err := dependsOn(tx, string(dependency.DependsOn()), string(dependency.Task()))
+		if err != nil {
+			return err
+		}
+
+		if reachable {
+			return domain.ErrDependencyCycle
+		}
+
+		model := DependencyModel{
+			TaskID:      string(dependency.Task()),
+			DependsOnID: string(dependency.DependsOn()),
+		}
+		onConflict := clause.OnConflict{DoNothing: true} //nolint:exhaustruct
+
+		return tx.Clauses(onConflict).Create(&model).Error
+	})
+}
+
+func (r *Repository) RemoveDependency(ctx context.Context, dependency domain.Dependency) error {
+	result := r.db.WithContext(ctx).
+		Where("task_id = ? AND depends_on_id = ?", string(dependency.Task()), string(dependency.DependsOn())).
+		Delete(&DependencyModel{}) //nolint:exhaustruct
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return core.ErrDependencyNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ListDependencies(ctx context.Context, ids []domain.TaskID) ([]domain.Dependency, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	taskIDs := make([]string, len(ids))
+	for i, id := range ids {
+		taskIDs[i] = string(id)
+	}
+
+	var models []DependencyModel
+
+	err := r.db.WithContext(ctx).
+		Where("task_id IN ?", taskIDs).
+		Order("task_id").Order("depends_on_id").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainDependencies(models), nil
+}
+
+// dependsOn from에서 의존성을 따라가 to에 도달할 수 있는지 확인합니다.
+// 지나가는 Task를 의존성을 읽기 전에 잠가서, 확인이 끝날 때까지 다른 트랜잭션이 그 Task에 의존성을 추가하지 못하게 합니다.
+func dependsOn(tx *gorm.DB, from, to string) (bool, error) {
+	visited := map[string]bool{from: true}
+
+	for frontier := []string{from}; len(frontier) > 0; {
+		if slices.Contains(frontier, to) {
+			return true, nil
+		}
+
+		var (
+			locked []string
+			models []DependencyModel
+		)
+
+		// 휴지통에 있는 Task의 의존성도 복원하면 다시 살아나므로 함께 따라갑니다.
+		err := tx.Unscoped().
+			Model(&TaskModel{}). //nolint:exhaustruct
+			Clauses(forUpdate).
+			Where("id IN ?", frontier).
+			Order("id").
+			Pluck("id", &locked).Error
+		if err != nil {
+			return false, err
+		}
+
+		err = tx.Where("task_id IN ?", frontier).Find(&models).Error
+		if err != nil {
+			return false, err
+		}
+
+		frontier = frontier[:0]
+
+		for _, model := range models {
+			if visited[model.DependsOnID] {
+				continue
+			}
+
+			frontier = append(frontier, model.DependsOnID)
+			visited[model.DependsOnID] = true
+		}
+	}
+
+	return false, nil
+}
+
+func (r *Repository) ListDependents(ctx context.Context, id domain.TaskID) ([]domain.Dependency, error) {
+	var models []DependencyModel
+
+	err := r.db.WithContext(ctx).
+		Where("depends_on_id = ?", string(id)).
+		Order("task_id").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainDependencies(models), nil
+}
@@ -0,0 +1,4 @@
+package orm
+
+// PurgeBatchSize 테스트에서 한 배치보다 많은 Task를 영구 삭제해 볼 수 있도록 purgeBatchSize를 노출합니다.
+const PurgeBatchSize = purgeBatchSize
@@ -1,7 +1,12 @@
 package orm
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/neatflowcv/tasker/internal/pkg/domain"
 	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
@@ -12,122 +17,461 @@ import (
 
 var _ core.Repository = (*Repository)(nil)
 
+// noDueDateLayout SQLite 드라이버가 시각을 저장하는 형식으로, PostgreSQL도 같은 리터럴을 해석할 수 있습니다.
+const noDueDateLayout = "2006-01-02 15:04:05-07:00"
+
 type TaskModel struct {
 	ID          string `gorm:"primaryKey"`
 	Title       string `gorm:"not null"`
 	Description string
+	Status      string `gorm:"not null;default:open"`
+	// Priority 정렬할 수 있도록 domain.TaskPriority의 Rank로 저장합니다.
+	Priority int        `gorm:"not null;default:2;index"`
+	DueDate  *time.Time `gorm:"index"`
+	// ParentID 최상위 Task는 빈 문자열입니다.
+	ParentID string `gorm:"not null;default:'';index"`
+	// ProjectID 프로젝트에 속하지 않은 Task는 빈 문자열입니다.
+	ProjectID string `gorm:"not null;default:'';index"`
+	// Key 프로젝트에 속한 Task의 "키-번호" 형식 키이며, 프로젝트에 속하지 않은 Task는 빈 문자열입니다.
+	Key string `gorm:"not null;default:'';index"`
+	// Rank 보드 열 안에서의 순서입니다. 빈 문자열이면 domain.NewTask가 ID로 정합니다.
+	Rank    string `gorm:"not null;default:''"`
+	Version int    `gorm:"not null;default:1"`
+	// 시각은 flow.Service의 clock으로 정하므로 GORM의 자동 기록을 끕니다.
+	CreatedAt time.Time `gorm:"autoCreateTime:false"`
+	CreatedBy string
+	UpdatedAt time.Time `gorm:"autoUpdateTime:false"`
+	UpdatedBy string
+	// DeletedAt 휴지통으로 옮겨진 시각입니다. GORM이 조회와 갱신에서 삭제된 Task를 자동으로 제외합니다.
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	// Tags task_tags 테이블을 통해 연결되며 조회할 때만 Preload로 채웁니다. 연결은 UpdateTask에서 직접 기록합니다.
+	Tags      []TagModel      `gorm:"many2many:task_tags;joinForeignKey:TaskID;joinReferences:TagID"`
+	Checklist ChecklistColumn `gorm:"not null;default:'[]'"`
 }
 
 func (TaskModel) TableName() string {
 	return "tasks"
 }
 
+func toDomainTask(model *TaskModel) *domain.Task {
+	return domain.NewTask(domain.TaskParams{
+		ID:          domain.TaskID(model.ID),
+		Title:       model.Title,
+		Description: model.Description,
+		Status:      domain.TaskStatus(model.Status),
+		Priority:    domain.TaskPriorityFromRank(model.Priority),
+		DueDate:     model.DueDate,
+		ParentID:    domain.TaskID(model.ParentID),
+		ProjectID:   domain.ProjectID(model.ProjectID),
+		Key:         model.Key,
+		Rank:        model.Rank,
+		Tags:        toDomainTags(model.Tags),
+		Checklist:   model.Checklist.toDomain(),
+		Version:     model.Version,
+		Created:     domain.NewStamp(model.CreatedAt, model.CreatedBy),
+		Updated:     domain.NewStamp(model.UpdatedAt, model.UpdatedBy),
+		DeletedAt:   deletedAt(model.DeletedAt),
+	})
+}
+
 type Repository struct {
 	db *gorm.DB
+	// collate 문자열 컬럼을 바이트 순서로 비교하는 COLLATE 절로, 정렬 결과가 DB의 기본 collation에 따라 달라지지 않게 합니다.
+	collate string
 }
 
-func NewRepository(dsn string) *Repository {
+func NewRepository(dsn string) (*Repository, error) {
+	return NewRepositoryWithDialector(postgres.Open(dsn))
+}
+
+// NewRepositoryWithDialector PostgreSQL 외의 GORM 드라이버(예: 테스트용 SQLite)로 Repository를 생성합니다.
+func NewRepositoryWithDialector(dialector gorm.Dialector) (*Repository, error) {
 	var config gorm.Config
 
-	db, err := gorm.Open(postgres.Open(dsn), &config)
+	// 태그 이름 중복을 드라이버와 관계없이 gorm.ErrDuplicatedKey로 확인합니다.
+	config.TranslateError = true
+
+	db, err := gorm.Open(dialector, &config)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to connect database: %w", err)
 	}
 
-	err = db.AutoMigrate(&TaskModel{}) //nolint:exhaustruct
+	err = db.SetupJoinTable(&TaskModel{}, "Tags", &TaskTagModel{}) //nolint:exhaustruct
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
 
-	return &Repository{db: db}
+	//nolint:exhaustruct
+	err = db.AutoMigrate(&TaskModel{}, &TagModel{}, &TaskTagModel{}, &DependencyModel{}, &AuditEntryModel{},
+		&TaskRevisionModel{}, &CommentModel{}, &AttachmentModel{}, &ProjectModel{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	return &Repository{db: db, collate: byteCollation(dialector.Name())}, nil
 }
 
-func (r *Repository) CreateTask(spec *domain.TaskSpec) (*domain.Task, error) {
+func (r *Repository) CreateTask(
+	ctx context.Context,
+	spec *domain.TaskSpec,
+	stamp domain.Stamp,
+) (*domain.Task, error) {
 	taskID := ulid.Make()
 	taskModel := TaskModel{
 		ID:          taskID.String(),
 		Title:       spec.Title(),
 		Description: spec.Description(),
+		Status:      string(spec.Status()),
+		Priority:    spec.Priority().Rank(),
+		DueDate:     spec.DueDate(),
+		ParentID:    string(spec.ParentID()),
+		ProjectID:   string(spec.ProjectID()),
+		Key:         "",
+		Rank:        "",
+		Version:     1,
+		CreatedAt:   stamp.At(),
+		CreatedBy:   stamp.By(),
+		UpdatedAt:   stamp.At(),
+		UpdatedBy:   stamp.By(),
+		DeletedAt:   gorm.DeletedAt{}, //nolint:exhaustruct
+		Tags:        nil,
+		Checklist:   ChecklistColumn{},
 	}
 
-	err := r.db.Create(&taskModel).Error
+	var task *domain.Task
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+
+		taskModel.Key, err = nextTaskKey(tx, spec.ProjectID())
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&taskModel).Error
+		if err != nil {
+			return err
+		}
+
+		task = toDomainTask(&taskModel)
+
+		err = createRevision(tx, task)
+		if err != nil {
+			return err
+		}
+
+		return appendAudit(tx, domain.NewAuditEntry(domain.AuditActionCreate, nil, task, stamp))
+	})
 	if err != nil {
-		return nil, err
+		return nil, err //nolint:wrapcheck
 	}
 
-	return domain.NewTask(
-		domain.TaskID(taskModel.ID),
-		taskModel.Title,
-		taskModel.Description,
-	), nil
+	return task, nil
 }
 
-func (r *Repository) ListTasks() ([]*domain.Task, error) {
+func (r *Repository) ListTasks(ctx context.Context, query *core.ListTasksQuery) (*core.TaskPage, error) {
+	column := sortColumn(query.Sort.Field, r.collate)
+
+	direction, operator := "ASC", ">"
+	if query.Sort.Descending {
+		direction, operator = "DESC", "<"
+	}
+
+	tx := applyTaskFilter(r.db.WithContext(ctx).Model(&TaskModel{}).Preload("Tags"), &query.Filter) //nolint:exhaustruct
+
+	if query.Cursor != nil {
+		if column == "id" {
+			tx = tx.Where("id "+operator+" ?", string(query.Cursor.ID))
+		} else {
+			value := cursorValue(query.Sort.Field, query.Cursor.Value)
+			tx = tx.Where(
+				column+" "+operator+" ? OR ("+column+" = ? AND id "+operator+" ?)",
+				value, value, string(query.Cursor.ID),
+			)
+		}
+	}
+
+	if column != "id" {
+		tx = tx.Order(column + " " + direction)
+	}
+
 	var taskModels []TaskModel
-	if err := r.db.Find(&taskModels).Error; err != nil {
+
+	err := tx.Order("id " + direction).Limit(query.Limit + 1).Find(&taskModels).Error
+	if err != nil {
 		return nil, err
 	}
 
 	tasks := make([]*domain.Task, len(taskModels))
-	for i, model := range taskModels {
-		tasks[i] = domain.NewTask(
-			domain.TaskID(model.ID),
-			model.Title,
-			model.Description,
-		)
+	for i := range taskModels {
+		tasks[i] = toDomainTask(&taskModels[i])
 	}
 
-	return tasks, nil
+	page := &core.TaskPage{Tasks: tasks, NextCursor: nil}
+	if len(tasks) > query.Limit {
+		page.Tasks = tasks[:query.Limit]
+		page.NextCursor = core.NewCursor(query.Sort, page.Tasks[query.Limit-1])
+	}
+
+	return page, nil
 }
 
-func (r *Repository) GetTask(id domain.TaskID) (*domain.Task, error) {
-	var taskModel TaskModel
+func applyTaskFilter(tx *gorm.DB, filter *core.TaskFilter) *gorm.DB {
+	if filter.Deleted {
+		tx = tx.Unscoped().Where("deleted_at IS NOT NULL")
+	}
 
-	err := r.db.First(&taskModel, "id = ?", string(id)).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, core.ErrTaskNotFound
+	tx = applyIdentityFilter(tx, filter)
+	tx = applyStatusAndPriorityFilter(tx, filter)
+
+	if len(filter.Tags) > 0 {
+		tx = tx.Where("id IN (?)", taggedTaskIDs(tx, filter.Tags, filter.TagMatch))
+	}
+
+	if filter.Search != "" {
+		tx = tx.Where(`LOWER(title) LIKE ? ESCAPE '\'`, "%"+escapeLike(strings.ToLower(filter.Search))+"%")
+	}
+
+	if filter.DueBefore != nil {
+		tx = tx.Where("due_date < ?", *filter.DueBefore)
+	}
+
+	if filter.DueAfter != nil {
+		tx = tx.Where("due_date >= ?", *filter.DueAfter)
+	}
+
+	if filter.OverdueAt != nil {
+		tx = tx.Where("due_date < ? AND status NOT IN ?", *filter.OverdueAt,
+			[]string{string(domain.TaskStatusDone), string(domain.TaskStatusCancelled)})
+	}
+
+	return tx
+}
+
+func applyIdentityFilter(tx *gorm.DB, filter *core.TaskFilter) *gorm.DB {
+	if len(filter.IDs) > 0 {
+		ids := make([]string, len(filter.IDs))
+		for i, id := range filter.IDs {
+			ids[i] = string(id)
 		}
 
-		return nil, err
+		tx = tx.Where("id IN ?", ids)
+	}
+
+	if filter.ParentID != "" {
+		tx = tx.Where("parent_id = ?", string(filter.ParentID))
+	}
+
+	if filter.ProjectID != "" {
+		tx = tx.Where("project_id = ?", string(filter.ProjectID))
+	}
+
+	if filter.Key != "" {
+		tx = tx.Where("key = ?", filter.Key)
 	}
 
-	return domain.NewTask(
-		domain.TaskID(taskModel.ID),
-		taskModel.Title,
-		taskModel.Description,
-	), nil
+	return tx
 }
 
-func (r *Repository) UpdateTask(task *domain.Task) (*domain.Task, error) {
-	taskModel := TaskModel{
-		ID:          string(task.ID()),
-		Title:       task.Title(),
-		Description: task.Description(),
+func applyStatusAndPriorityFilter(tx *gorm.DB, filter *core.TaskFilter) *gorm.DB {
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+
+		tx = tx.Where("status IN ?", statuses)
 	}
 
-	if err := r.db.Save(&taskModel).Error; err != nil {
-		return nil, err
+	if len(filter.Priorities) > 0 {
+		ranks := make([]int, len(filter.Priorities))
+		for i, priority := range filter.Priorities {
+			ranks[i] = priority.Rank()
+		}
+
+		tx = tx.Where("priority IN ?", ranks)
 	}
 
-	return domain.NewTask(
-		domain.TaskID(taskModel.ID),
-		taskModel.Title,
-		taskModel.Description,
-	), nil
+	return tx
 }
 
-func (r *Repository) DeleteTask(id domain.TaskID) error {
-	result := r.db.Delete(&TaskModel{ //nolint:exhaustruct
-		ID: string(id),
+// sortColumn 정렬 필드에 해당하는 SQL 식을 반환합니다. 문자열 컬럼에는 collate를 붙여 바이트 순서로 비교합니다.
+// 마감일이 없는 Task는 core.NoDueDate와 같은 값으로 정렬되어 fake 구현과 순서가 같습니다.
+func sortColumn(field core.TaskSortField, collate string) string {
+	switch field {
+	case core.TaskSortTitle:
+		return "title" + collate
+	case core.TaskSortStatus:
+		return "status" + collate
+	case core.TaskSortCreatedAt:
+		return "created_at"
+	case core.TaskSortUpdatedAt:
+		return "updated_at"
+	case core.TaskSortPriority:
+		return "priority"
+	case core.TaskSortDueDate:
+		return "COALESCE(due_date, '" + core.NoDueDate().Format(noDueDateLayout) + "')"
+	case core.TaskSortID:
+		return "id"
+	default:
+		return "id"
+	}
+}
+
+// byteCollation 문자열을 바이트 순서로 비교하는 드라이버별 COLLATE 절을 반환합니다.
+// SQLite는 기본 비교가 바이트 순서이므로 따로 지정하지 않습니다.
+func byteCollation(dialect string) string {
+	if dialect == "postgres" {
+		return ` COLLATE "C"`
+	}
+
+	return ""
+}
+
+// cursorValue 커서 값을 컬럼과 비교할 수 있는 타입으로 변환합니다.
+// 커서 형식은 core.DecodeCursor에서 이미 검증되었습니다.
+func cursorValue(field core.TaskSortField, value string) any {
+	switch {
+	case field.IsTime():
+		at, _ := time.Parse(core.TimeKeyLayout, value)
+
+		return at
+	case field == core.TaskSortPriority:
+		rank, _ := strconv.Atoi(value)
+
+		return rank
+	default:
+		return value
+	}
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+func (r *Repository) GetTask(ctx context.Context, id domain.TaskID) (*domain.Task, error) {
+	return findTask(r.db.WithContext(ctx), id)
+}
+
+func (r *Repository) UpdateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	var updated *domain.Task
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+
+		updated, err = updateTask(tx, task)
+
+		return err
 	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return updated, nil
+}
+
+// updateTask 버전을 확인하여 Task를 갱신하고 태그 연결을 task의 태그로 바꾼 뒤 리비전과 감사 기록을 남깁니다.
+func updateTask(tx *gorm.DB, task *domain.Task) (*domain.Task, error) {
+	tags, err := findTags(tx, task.Tags())
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := findTask(tx, task.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if stored.Version() != task.Version() {
+		return nil, core.ErrConflict
+	}
+
+	// 읽은 뒤에 다른 트랜잭션이 갱신했을 수 있으므로 저장된 버전이 같을 때만 갱신합니다.
+	result := tx.
+		Model(&TaskModel{}). //nolint:exhaustruct
+		Where("id = ? AND version = ?", string(task.ID()), task.Version()).
+		Updates(map[string]any{
+			"title":       task.Title(),
+			"description": task.Description(),
+			"status":      string(task.Status()),
+			"priority":    task.Priority().Rank(),
+			"due_date":    task.DueDate(),
+			"parent_id":   string(task.ParentID()),
+			"rank":        task.Rank(),
+			"checklist":   newChecklistColumn(task.Checklist()),
+			"version":     gorm.Expr("version + 1"),
+			"updated_at":  task.Updated().At(),
+			"updated_by":  task.Updated().By(),
+		})
 	if result.Error != nil {
-		return result.Error
+		return nil, result.Error
 	}
 
 	if result.RowsAffected == 0 {
-		return core.ErrTaskNotFound
+		return nil, core.ErrConflict
+	}
+
+	err = replaceTaskTags(tx, task.ID(), tags)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := task.WithTags(toDomainTags(tags)).WithVersion(task.Version() + 1)
+
+	err = createRevision(tx, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	err = appendAudit(tx, domain.NewAuditEntry(domain.AuditActionUpdate, stored, updated, updated.Updated()))
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteTask Task를 휴지통으로 옮깁니다. 삭제 시각은 GORM이 아닌 호출자가 정합니다.
+func (r *Repository) DeleteTask(ctx context.Context, id domain.TaskID, stamp domain.Stamp) error {
+	//nolint:wrapcheck
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		task, err := findTask(tx, id)
+		if err != nil {
+			return err
+		}
+
+		err = tx.
+			Model(&TaskModel{}). //nolint:exhaustruct
+			Where("id = ?", string(id)).
+			Update("deleted_at", stamp.At()).Error
+		if err != nil {
+			return err
+		}
+
+		deletedAt := stamp.At()
+		deleted := task.WithDeletedAt(&deletedAt)
+
+		return appendAudit(tx, domain.NewAuditEntry(domain.AuditActionDelete, task, deleted, stamp))
+	})
+}
+
+// findTask 휴지통에 있지 않은 Task를 태그와 함께 읽습니다. 없으면 core.ErrTaskNotFound를 반환합니다.
+func findTask(tx *gorm.DB, id domain.TaskID) (*domain.Task, error) {
+	var taskModel TaskModel
+
+	err := tx.Preload("Tags").First(&taskModel, "id = ?", string(id)).Error
+	if err != nil {
+		return nil, translateNotFound(err, core.ErrTaskNotFound)
+	}
+
+	return toDomainTask(&taskModel), nil
+}
+
+// translateNotFound 레코드가 없다는 드라이버 에러를 notFound로 변환합니다.
+func translateNotFound(err, notFound error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound
 	}
 
-	return nil
+	return err
 }
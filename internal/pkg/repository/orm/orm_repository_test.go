@@ -0,0 +1,196 @@
+package orm_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/conformance"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/orm"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestRepositorySQLite(t *testing.T) {
+	t.Parallel()
+
+	conformance.Run(t, func(t *testing.T) core.Repository {
+		t.Helper()
+
+		repo, err := orm.NewRepositoryWithDialector(sqlite.Open(filepath.Join(t.TempDir(), "tasker.db")))
+		if err != nil {
+			t.Fatalf("NewRepositoryWithDialector: %v", err)
+		}
+
+		return repo
+	})
+}
+
+// TestPurgeDeletedTasksInBatches 한 배치보다 많은 Task도 모두 영구 삭제하는지 확인합니다.
+func TestPurgeDeletedTasksInBatches(t *testing.T) {
+	t.Parallel()
+
+	repo, err := orm.NewRepositoryWithDialector(sqlite.Open(filepath.Join(t.TempDir(), "tasker.db")))
+	if err != nil {
+		t.Fatalf("NewRepositoryWithDialector: %v", err)
+	}
+
+	stamp := domain.NewStamp(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), "tester")
+
+	spec, err := domain.NewTaskSpec(domain.TaskSpecParams{ //nolint:exhaustruct
+		Title:  "task",
+		Status: domain.TaskStatusOpen,
+	})
+	if err != nil {
+		t.Fatalf("NewTaskSpec: %v", err)
+	}
+
+	for range orm.PurgeBatchSize + 1 {
+		task, err := repo.CreateTask(t.Context(), spec, stamp)
+		if err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+
+		err = repo.DeleteTask(t.Context(), task.ID(), stamp)
+		if err != nil {
+			t.Fatalf("DeleteTask: %v", err)
+		}
+	}
+
+	purged, err := repo.PurgeDeletedTasks(t.Context(), stamp.At().Add(time.Second), stamp)
+	if err != nil || len(purged) != orm.PurgeBatchSize+1 {
+		t.Fatalf("PurgeDeletedTasks = %d tasks, %v; want %d", len(purged), err, orm.PurgeBatchSize+1)
+	}
+
+	var query core.ListTasksQuery
+
+	query.Filter.Deleted = true
+	query.Limit = core.MaxListLimit
+
+	page, err := repo.ListTasks(t.Context(), &query)
+	if err != nil || len(page.Tasks) != 0 {
+		t.Fatalf("ListTasks in trash after purge = %v, %v; want none", page, err)
+	}
+}
+
+// TestRepositoryPostgres TASKER_TEST_POSTGRES_DSN이 설정된 경우에만 실제 PostgreSQL에 대해 실행됩니다.
+// 각 테스트는 별도의 스키마를 사용하므로 기존 데이터에 영향을 주지 않습니다.
+func TestRepositoryPostgres(t *testing.T) {
+	t.Parallel()
+
+	dsn := os.Getenv("TASKER_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TASKER_TEST_POSTGRES_DSN is not set")
+	}
+
+	conformance.Run(t, func(t *testing.T) core.Repository {
+		t.Helper()
+
+		return newPostgresRepository(t, dsn)
+	})
+}
+
+func newPostgresRepository(t *testing.T, dsn string) *orm.Repository {
+	t.Helper()
+
+	admin, err := gorm.Open(postgres.Open(dsn), &gorm.Config{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("connect postgres: %v", err)
+	}
+
+	schema := schemaName(t.Name())
+
+	err = admin.Exec("DROP SCHEMA IF EXISTS " + schema + " CASCADE; CREATE SCHEMA " + schema).Error
+	if err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	t.Cleanup(func() {
+		admin.Exec("DROP SCHEMA IF EXISTS " + schema + " CASCADE")
+	})
+
+	repo, err := orm.NewRepositoryWithDialector(postgres.Open(withSearchPath(t, dsn, schema)))
+	if err != nil {
+		t.Fatalf("NewRepositoryWithDialector: %v", err)
+	}
+
+	return repo
+}
+
+// withSearchPath URL 형식과 key=value 형식의 DSN 모두에 search_path를 추가합니다.
+func withSearchPath(t *testing.T, dsn, schema string) string {
+	t.Helper()
+
+	if !strings.Contains(dsn, "://") {
+		return dsn + " search_path=" + schema
+	}
+
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		t.Fatalf("parse dsn: %v", err)
+	}
+
+	query := parsed.Query()
+	query.Set("search_path", schema)
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String()
+}
+
+func TestSchemaName(t *testing.T) {
+	t.Parallel()
+
+	prefix := "TestRepositoryPostgres/" + strings.Repeat("Long", maxIdentifierLength)
+
+	first, second := schemaName(prefix+"A"), schemaName(prefix+"B")
+	if len(first) > maxIdentifierLength || len(second) > maxIdentifierLength || first == second {
+		t.Errorf("schemaName = %q, %q, want distinct names of at most %d bytes", first, second, maxIdentifierLength)
+	}
+
+	if got, want := schemaName("TestRepositoryPostgres/Tasks"), "conformance_testrepositorypostgres_tasks"; got != want {
+		t.Errorf("schemaName = %q, want %q", got, want)
+	}
+}
+
+// maxIdentifierLength PostgreSQL 식별자의 최대 바이트 수입니다. 더 긴 이름은 잘려서 다른 테스트의 스키마와 겹칠 수 있습니다.
+const maxIdentifierLength = 63
+
+// schemaName 테스트 이름으로 스키마 이름을 만듭니다. 식별자 길이를 넘으면 앞부분만 남기고
+// 테스트 이름의 해시를 붙여 서로 다른 테스트가 같은 스키마를 쓰지 않게 합니다.
+func schemaName(testName string) string {
+	const hashLength = 16
+
+	name := "conformance_" + sanitize(testName)
+	if len(name) <= maxIdentifierLength {
+		return name
+	}
+
+	sum := sha256.Sum256([]byte(testName))
+
+	return name[:maxIdentifierLength-hashLength-1] + "_" + hex.EncodeToString(sum[:])[:hashLength]
+}
+
+func sanitize(name string) string {
+	ret := make([]byte, 0, len(name))
+
+	for _, c := range []byte(name) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			ret = append(ret, c)
+		case c >= 'A' && c <= 'Z':
+			ret = append(ret, c+'a'-'A')
+		default:
+			ret = append(ret, '_')
+		}
+	}
+
+	return string(ret)
+}
@@ -0,0 +1,224 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+var _ core.ProjectRepository = (*Repository)(nil)
+
+type ProjectModel struct {
+	ID          string `gorm:"primaryKey"`
+	Key         string `gorm:"not null;uniqueIndex"`
+	Name        string `gorm:"not null"`
+	Description string
+	// DefaultPriority domain.TaskPriority의 Rank로 저장합니다.
+	DefaultPriority int `gorm:"not null;default:2"`
+	// TaskSeq 마지막에 배정한 Task 번호입니다. CreateTask가 트랜잭션 안에서 1씩 증가시킵니다.
+	TaskSeq int `gorm:"not null;default:0"`
+	// Columns 보드의 열입니다. 빈 배열이면 기본 열을 씁니다.
+	Columns   BoardColumnsColumn `gorm:"not null;default:'[]'"`
+	Version   int                `gorm:"not null;default:1"`
+	CreatedAt time.Time          `gorm:"autoCreateTime:false"`
+	CreatedBy string
+	UpdatedAt time.Time `gorm:"autoUpdateTime:false"`
+	UpdatedBy string
+}
+
+func (ProjectModel) TableName() string {
+	return "projects"
+}
+
+func toDomainProject(model *ProjectModel) *domain.Project {
+	return domain.NewProject(domain.ProjectParams{
+		ID:              domain.ProjectID(model.ID),
+		Key:             domain.ProjectKey(model.Key),
+		Name:            model.Name,
+		Description:     model.Description,
+		DefaultPriority: domain.TaskPriorityFromRank(model.DefaultPriority),
+		Columns:         model.Columns.toDomain(),
+		Version:         model.Version,
+		Created:         domain.NewStamp(model.CreatedAt, model.CreatedBy),
+		Updated:         domain.NewStamp(model.UpdatedAt, model.UpdatedBy),
+	})
+}
+
+func (r *Repository) CreateProject(
+	ctx context.Context,
+	key domain.ProjectKey,
+	spec *domain.ProjectSpec,
+	stamp domain.Stamp,
+) (*domain.Project, error) {
+	projectModel := ProjectModel{
+		ID:              ulid.Make().String(),
+		Key:             string(key),
+		Name:            spec.Name(),
+		Description:     spec.Description(),
+		DefaultPriority: spec.DefaultPriority().Rank(),
+		TaskSeq:         0,
+		Columns:         BoardColumnsColumn{},
+		Version:         1,
+		CreatedAt:       stamp.At(),
+		CreatedBy:       stamp.By(),
+		UpdatedAt:       stamp.At(),
+		UpdatedBy:       stamp.By(),
+	}
+
+	err := r.db.WithContext(ctx).Create(&projectModel).Error
+	if err != nil {
+		return nil, translateProjectError(err)
+	}
+
+	return toDomainProject(&projectModel), nil
+}
+
+func (r *Repository) ListProjects(ctx context.Context) ([]*domain.Project, error) {
+	var projectModels []ProjectModel
+
+	err := r.db.WithContext(ctx).Order("key").Find(&projectModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make([]*domain.Project, len(projectModels))
+	for i := range projectModels {
+		projects[i] = toDomainProject(&projectModels[i])
+	}
+
+	return projects, nil
+}
+
+func (r *Repository) GetProject(ctx context.Context, id domain.ProjectID) (*domain.Project, error) {
+	var projectModel ProjectModel
+
+	err := r.db.WithContext(ctx).First(&projectModel, "id = ?", string(id)).Error
+	if err != nil {
+		return nil, translateProjectError(err)
+	}
+
+	return toDomainProject(&projectModel), nil
+}
+
+// UpdateProject 키와 생성 정보는 바꾸지 않고 보드 열을 포함한 나머지를 저장한 뒤 저장된 값을 반환합니다.
+func (r *Repository) UpdateProject(ctx context.Context, project *domain.Project) (*domain.Project, error) {
+	var updated *domain.Project
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 읽은 뒤에 다른 트랜잭션이 갱신했을 수 있으므로 저장된 버전이 같을 때만 갱신합니다.
+		result := tx.
+			Model(&ProjectModel{}). //nolint:exhaustruct
+			Where("id = ? AND version = ?", string(project.ID()), project.Version()).
+			Updates(map[string]any{
+				"name":             project.Name(),
+				"description":      project.Description(),
+				"default_priority": project.DefaultPriority().Rank(),
+				"columns":          newBoardColumnsColumn(project.Columns()),
+				"version":          gorm.Expr("version + 1"),
+				"updated_at":       project.Updated().At(),
+				"updated_by":       project.Updated().By(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		var projectModel ProjectModel
+
+		err := tx.First(&projectModel, "id = ?", string(project.ID())).Error
+		if err != nil {
+			return translateProjectError(err)
+		}
+
+		if result.RowsAffected == 0 {
+			return core.ErrProjectConflict
+		}
+
+		updated = toDomainProject(&projectModel)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return updated, nil
+}
+
+func (r *Repository) DeleteProject(ctx context.Context, id domain.ProjectID) error {
+	//nolint:wrapcheck
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+
+		// 휴지통에 있는 Task도 복원되면 프로젝트가 필요하므로 함께 셉니다.
+		err := tx.Unscoped().
+			Model(&TaskModel{}). //nolint:exhaustruct
+			Where("project_id = ?", string(id)).
+			Count(&count).Error
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return core.ErrProjectNotEmpty
+		}
+
+		result := tx.Delete(&ProjectModel{ //nolint:exhaustruct
+			ID: string(id),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return core.ErrProjectNotFound
+		}
+
+		return nil
+	})
+}
+
+// nextTaskKey 프로젝트의 Task 번호를 1 증가시켜 Task 키를 만듭니다. 프로젝트가 지정되지 않으면 빈 키를 반환합니다.
+// 증가와 조회가 같은 트랜잭션 안에서 이루어지므로 동시에 만든 Task도 서로 다른 번호를 받습니다.
+func nextTaskKey(tx *gorm.DB, id domain.ProjectID) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	result := tx.
+		Model(&ProjectModel{}). //nolint:exhaustruct
+		Where("id = ?", string(id)).
+		Update("task_seq", gorm.Expr("task_seq + 1"))
+	if result.Error != nil {
+		return "", result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return "", core.ErrProjectNotFound
+	}
+
+	var projectModel ProjectModel
+
+	err := tx.First(&projectModel, "id = ?", string(id)).Error
+	if err != nil {
+		return "", translateProjectError(err)
+	}
+
+	return toDomainProject(&projectModel).TaskKey(projectModel.TaskSeq), nil
+}
+
+// translateProjectError 드라이버 에러를 core 에러로 변환합니다.
+func translateProjectError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return core.ErrProjectNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return core.ErrDuplicateProject
+	default:
+		return err
+	}
+}
@@ -0,0 +1,157 @@
+package orm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"gorm.io/gorm"
+)
+
+var _ core.RevisionRepository = (*Repository)(nil)
+
+// TaskRevisionModel Task 버전 하나의 스냅샷입니다. 컬럼은 TaskModel과 같으며,
+// 태그는 이후에 바뀌거나 삭제되어도 저장 당시의 값이 남도록 JSON으로 저장합니다.
+type TaskRevisionModel struct {
+	TaskID      string `gorm:"primaryKey"`
+	Version     int    `gorm:"primaryKey;autoIncrement:false"`
+	Title       string `gorm:"not null"`
+	Description string
+	Status      string `gorm:"not null"`
+	Priority    int    `gorm:"not null"`
+	DueDate     *time.Time
+	ParentID    string    `gorm:"not null;default:''"`
+	ProjectID   string    `gorm:"not null;default:''"`
+	Key         string    `gorm:"not null;default:''"`
+	Rank        string    `gorm:"not null;default:''"`
+	CreatedAt   time.Time `gorm:"autoCreateTime:false"`
+	CreatedBy   string
+	UpdatedAt   time.Time `gorm:"autoUpdateTime:false"`
+	UpdatedBy   string
+	// Tags []revisionTag를 JSON으로 저장합니다.
+	Tags      string          `gorm:"not null;default:'[]'"`
+	Checklist ChecklistColumn `gorm:"not null;default:'[]'"`
+}
+
+func (TaskRevisionModel) TableName() string {
+	return "task_revisions"
+}
+
+type revisionTag struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+func newTaskRevisionModel(task *domain.Task) (*TaskRevisionModel, error) {
+	tags := make([]revisionTag, len(task.Tags()))
+	for i, tag := range task.Tags() {
+		tags[i] = revisionTag{ID: string(tag.ID()), Name: tag.Name(), Color: tag.Color()}
+	}
+
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode revision tags: %w", err)
+	}
+
+	return &TaskRevisionModel{
+		TaskID:      string(task.ID()),
+		Version:     task.Version(),
+		Title:       task.Title(),
+		Description: task.Description(),
+		Status:      string(task.Status()),
+		Priority:    task.Priority().Rank(),
+		DueDate:     task.DueDate(),
+		ParentID:    string(task.ParentID()),
+		ProjectID:   string(task.ProjectID()),
+		Key:         task.Key(),
+		Rank:        task.Rank(),
+		CreatedAt:   task.Created().At(),
+		CreatedBy:   task.Created().By(),
+		UpdatedAt:   task.Updated().At(),
+		UpdatedBy:   task.Updated().By(),
+		Tags:        string(data),
+		Checklist:   newChecklistColumn(task.Checklist()),
+	}, nil
+}
+
+func toDomainRevision(model *TaskRevisionModel) (*domain.Task, error) {
+	var tags []revisionTag
+
+	err := json.Unmarshal([]byte(model.Tags), &tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode revision tags of %s@%d: %w", model.TaskID, model.Version, err)
+	}
+
+	domainTags := make([]*domain.Tag, len(tags))
+	for i, tag := range tags {
+		domainTags[i] = domain.NewTag(domain.TagID(tag.ID), tag.Name, tag.Color)
+	}
+
+	return domain.NewTask(domain.TaskParams{
+		ID:          domain.TaskID(model.TaskID),
+		Title:       model.Title,
+		Description: model.Description,
+		Status:      domain.TaskStatus(model.Status),
+		Priority:    domain.TaskPriorityFromRank(model.Priority),
+		DueDate:     model.DueDate,
+		ParentID:    domain.TaskID(model.ParentID),
+		ProjectID:   domain.ProjectID(model.ProjectID),
+		Key:         model.Key,
+		Rank:        model.Rank,
+		Tags:        domainTags,
+		Checklist:   model.Checklist.toDomain(),
+		Version:     model.Version,
+		Created:     domain.NewStamp(model.CreatedAt, model.CreatedBy),
+		Updated:     domain.NewStamp(model.UpdatedAt, model.UpdatedBy),
+		DeletedAt:   nil,
+	}), nil
+}
+
+func (r *Repository) ListRevisions(ctx context.Context, id domain.TaskID) ([]*domain.Task, error) {
+	var models []TaskRevisionModel
+
+	err := r.db.WithContext(ctx).Where("task_id = ?", string(id)).Order("version").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*domain.Task, len(models))
+	for i := range models {
+		revisions[i], err = toDomainRevision(&models[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return revisions, nil
+}
+
+func (r *Repository) GetRevision(ctx context.Context, id domain.TaskID, version int) (*domain.Task, error) {
+	var model TaskRevisionModel
+
+	err := r.db.WithContext(ctx).First(&model, "task_id = ? AND version = ?", string(id), version).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, core.ErrRevisionNotFound
+		}
+
+		return nil, err
+	}
+
+	return toDomainRevision(&model)
+}
+
+// createRevision 저장된 Task를 그 버전의 리비전으로 기록합니다.
+func createRevision(tx *gorm.DB, task *domain.Task) error {
+	model, err := newTaskRevisionModel(task)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(model).Error
+}
@@ -0,0 +1,273 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+var _ core.TagRepository = (*Repository)(nil)
+
+type TagModel struct {
+	ID    string `gorm:"primaryKey"`
+	Name  string `gorm:"not null;uniqueIndex"`
+	Color string
+}
+
+func (TagModel) TableName() string {
+	return "tags"
+}
+
+// TaskTagModel Task와 태그의 다대다 연결입니다.
+type TaskTagModel struct {
+	TaskID string `gorm:"primaryKey"`
+	TagID  string `gorm:"primaryKey;index"`
+}
+
+func (TaskTagModel) TableName() string {
+	return "task_tags"
+}
+
+func toDomainTag(model *TagModel) *domain.Tag {
+	return domain.NewTag(domain.TagID(model.ID), model.Name, model.Color)
+}
+
+func toDomainTags(models []TagModel) []*domain.Tag {
+	tags := make([]*domain.Tag, len(models))
+	for i := range models {
+		tags[i] = toDomainTag(&models[i])
+	}
+
+	return tags
+}
+
+func (r *Repository) CreateTag(ctx context.Context, spec *domain.TagSpec) (*domain.Tag, error) {
+	tagModel := TagModel{
+		ID:    ulid.Make().String(),
+		Name:  spec.Name(),
+		Color: spec.Color(),
+	}
+
+	err := r.db.WithContext(ctx).Create(&tagModel).Error
+	if err != nil {
+		return nil, translateTagError(err)
+	}
+
+	return toDomainTag(&tagModel), nil
+}
+
+func (r *Repository) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	var tagModels []TagModel
+
+	err := r.db.WithContext(ctx).Order("name").Find(&tagModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTags(tagModels), nil
+}
+
+func (r *Repository) GetTag(ctx context.Context, id domain.TagID) (*domain.Tag, error) {
+	var tagModel TagModel
+
+	err := r.db.WithContext(ctx).First(&tagModel, "id = ?", string(id)).Error
+	if err != nil {
+		return nil, translateTagError(err)
+	}
+
+	return toDomainTag(&tagModel), nil
+}
+
+func (r *Repository) UpdateTag(ctx context.Context, tag *domain.Tag, stamp domain.Stamp) (*domain.Tag, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tasks, err := taggedTasks(tx, tag.ID())
+		if err != nil {
+			return err
+		}
+
+		result := tx.
+			Model(&TagModel{}). //nolint:exhaustruct
+			Where("id = ?", string(tag.ID())).
+			Updates(map[string]any{
+				"name":  tag.Name(),
+				"color": tag.Color(),
+			})
+		if result.Error != nil {
+			return translateTagError(result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return core.ErrTagNotFound
+		}
+
+		return touchTasks(tx, tasks, stamp)
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return tag.Clone(), nil
+}
+
+func (r *Repository) DeleteTag(ctx context.Context, id domain.TagID, stamp domain.Stamp) error {
+	//nolint:wrapcheck
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tasks, err := taggedTasks(tx, id)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("tag_id = ?", string(id)).Delete(&TaskTagModel{}).Error //nolint:exhaustruct
+		if err != nil {
+			return err
+		}
+
+		result := tx.Delete(&TagModel{ //nolint:exhaustruct
+			ID: string(id),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return core.ErrTagNotFound
+		}
+
+		return touchTasks(tx, tasks, stamp)
+	})
+}
+
+// taggedTasks 태그 id가 붙은 휴지통에 있지 않은 Task를 태그와 함께 ID 순서로 읽습니다.
+func taggedTasks(tx *gorm.DB, id domain.TagID) ([]*domain.Task, error) {
+	db := tx.Session(&gorm.Session{NewDB: true}) //nolint:exhaustruct
+	subquery := db.Table("task_tags").Select("task_id").Where("tag_id = ?", string(id))
+
+	var taskModels []TaskModel
+
+	err := tx.Preload("Tags").Where("id IN (?)", subquery).Order("id").Find(&taskModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*domain.Task, len(taskModels))
+	for i := range taskModels {
+		tasks[i] = toDomainTask(&taskModels[i])
+	}
+
+	return tasks, nil
+}
+
+// touchTasks 태그나 상위 Task가 바뀐 Task를 stamp로 수정한 것으로 보아 버전을 올리고 리비전과 감사 기록을 남깁니다.
+// before는 바꾸기 전에 읽은 Task입니다.
+func touchTasks(tx *gorm.DB, before []*domain.Task, stamp domain.Stamp) error {
+	for _, task := range before {
+		result := tx.
+			Model(&TaskModel{}). //nolint:exhaustruct
+			Where("id = ? AND version = ?", string(task.ID()), task.Version()).
+			Updates(map[string]any{
+				"version":    gorm.Expr("version + 1"),
+				"updated_at": stamp.At(),
+				"updated_by": stamp.By(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return core.ErrConflict
+		}
+
+		updated, err := findTask(tx, task.ID())
+		if err != nil {
+			return err
+		}
+
+		err = createRevision(tx, updated)
+		if err != nil {
+			return err
+		}
+
+		err = appendAudit(tx, domain.NewAuditEntry(domain.AuditActionUpdate, task, updated, stamp))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findTags 저장된 태그를 읽습니다. 하나라도 없으면 core.ErrTagNotFound를 반환합니다.
+func findTags(tx *gorm.DB, tags []*domain.Tag) ([]TagModel, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(tags))
+	for i, tag := range tags {
+		ids[i] = string(tag.ID())
+	}
+
+	var tagModels []TagModel
+
+	err := tx.Where("id IN ?", ids).Order("name").Find(&tagModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tagModels) != len(ids) {
+		return nil, core.ErrTagNotFound
+	}
+
+	return tagModels, nil
+}
+
+// replaceTaskTags Task의 태그 연결을 tags로 바꿉니다.
+func replaceTaskTags(tx *gorm.DB, taskID domain.TaskID, tags []TagModel) error {
+	err := tx.Where("task_id = ?", string(taskID)).Delete(&TaskTagModel{}).Error //nolint:exhaustruct
+	if err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	links := make([]TaskTagModel, len(tags))
+	for i, tag := range tags {
+		links[i] = TaskTagModel{TaskID: string(taskID), TagID: tag.ID}
+	}
+
+	return tx.Create(&links).Error
+}
+
+// taggedTaskIDs 이름이 names인 태그가 붙은 Task ID를 찾는 서브쿼리입니다.
+// TagMatchAll이면 모든 태그가 붙은 Task만 찾습니다.
+func taggedTaskIDs(tx *gorm.DB, names []string, match core.TagMatch) *gorm.DB {
+	db := tx.Session(&gorm.Session{NewDB: true}) //nolint:exhaustruct
+	subquery := db.Table("task_tags").
+		Select("task_tags.task_id").
+		Joins("JOIN tags ON tags.id = task_tags.tag_id").
+		Where("tags.name IN ?", names)
+
+	if match == core.TagMatchAll {
+		subquery = subquery.Group("task_tags.task_id").Having("COUNT(DISTINCT tags.name) = ?", len(names))
+	}
+
+	return subquery
+}
+
+// translateTagError 드라이버 에러를 core 에러로 변환합니다.
+func translateTagError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return core.ErrTagNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return core.ErrDuplicateTag
+	default:
+		return err
+	}
+}
@@ -0,0 +1,226 @@
+package orm
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/neatflowcv/tasker/internal/pkg/domain"
+	"github.com/neatflowcv/tasker/internal/pkg/repository/core"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ core.TrashRepository = (*Repository)(nil)
+
+// purgeBatchSize 한 번의 IN 목록에 담는 Task ID 수입니다. 휴지통이 아무리 커도 쿼리의 바인드 파라미터 수가
+// 데이터베이스의 제한(PostgreSQL은 65535개)을 넘지 않도록 나누어 삭제합니다.
+const purgeBatchSize = 500
+
+// skipLocked 다른 트랜잭션이 잠근 행은 건너뛰고 읽은 행을 트랜잭션이 끝날 때까지 잠급니다.
+//
+//nolint:exhaustruct
+var skipLocked = clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}
+
+func deletedAt(value gorm.DeletedAt) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Time
+}
+
+func (r *Repository) RestoreTask(ctx context.Context, id domain.TaskID, stamp domain.Stamp) (*domain.Task, error) {
+	var restored *domain.Task
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var taskModel TaskModel
+
+		err := tx.Unscoped().Preload("Tags").
+			First(&taskModel, "id = ? AND deleted_at IS NOT NULL", string(id)).Error
+		if err != nil {
+			return translateNotFound(err, core.ErrTaskNotFound)
+		}
+
+		err = tx.Unscoped().
+			Model(&TaskModel{}). //nolint:exhaustruct
+			Where("id = ?", string(id)).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+
+		task := toDomainTask(&taskModel)
+		restored = task.WithDeletedAt(nil)
+
+		return appendAudit(tx, domain.NewAuditEntry(domain.AuditActionRestore, task, restored, stamp))
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return restored, nil
+}
+
+func (r *Repository) PurgeTask(ctx context.Context, id domain.TaskID, stamp domain.Stamp) error {
+	//nolint:wrapcheck
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids, err := deletedTaskIDs(tx.Where("id = ?", string(id)))
+		if err != nil {
+			return err
+		}
+
+		purged, err := purgeTasks(tx, ids, stamp)
+		if err != nil {
+			return err
+		}
+
+		if len(purged) == 0 {
+			return core.ErrTaskNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) PurgeDeletedTasks(
+	ctx context.Context,
+	before time.Time,
+	stamp domain.Stamp,
+) ([]domain.TaskID, error) {
+	var ids []string
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		candidates, err := deletedTaskIDs(tx.Where("deleted_at < ?", before))
+		if err != nil {
+			return err
+		}
+
+		for batch := range slices.Chunk(candidates, purgeBatchSize) {
+			purged, err := purgeTasks(tx, batch, stamp)
+			if err != nil {
+				return err
+			}
+
+			ids = append(ids, purged...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	ret := make([]domain.TaskID, len(ids))
+	for i, id := range ids {
+		ret[i] = domain.TaskID(id)
+	}
+
+	return ret, nil
+}
+
+// deletedTaskIDs tx의 조건에 맞는 휴지통의 Task ID를 ID 순서로 읽고 트랜잭션이 끝날 때까지 잠급니다.
+// 여러 인스턴스가 동시에 영구 삭제하면 다른 트랜잭션이 잠근 Task는 건너뜁니다. SQLite 드라이버는 잠금 절을 생략합니다.
+func deletedTaskIDs(tx *gorm.DB) ([]string, error) {
+	var ids []string
+
+	err := tx.Unscoped().
+		Model(&TaskModel{}). //nolint:exhaustruct
+		Clauses(skipLocked).
+		Where("deleted_at IS NOT NULL").
+		Order("id").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// purgeTasks Task와 태그 연결, 리비전, 댓글, Task가 양 끝 중 하나인 의존성을 영구 삭제하고 실제로 삭제한 Task의 ID를 반환합니다.
+// 실제로 삭제한 Task에만 stamp로 감사 기록을 남기고, 그 하위 Task는 최상위 Task로 만듭니다.
+func purgeTasks(tx *gorm.DB, ids []string, stamp domain.Stamp) ([]string, error) {
+	err := tx.Where("task_id IN ?", ids).Delete(&TaskTagModel{}).Error //nolint:exhaustruct
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Where("task_id IN ? OR depends_on_id IN ?", ids, ids).Delete(&DependencyModel{}).Error //nolint:exhaustruct
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Where("task_id IN ?", ids).Delete(&TaskRevisionModel{}).Error //nolint:exhaustruct
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Where("task_id IN ?", ids).Delete(&CommentModel{}).Error //nolint:exhaustruct
+	if err != nil {
+		return nil, err
+	}
+
+	// 다른 트랜잭션이 먼저 삭제한 Task에 감사 기록을 중복으로 남기지 않도록 실제로 삭제한 행만 돌려받습니다.
+	var taskModels []TaskModel
+
+	err = tx.Unscoped().
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}). //nolint:exhaustruct
+		Where("id IN ?", ids).
+		Delete(&taskModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(taskModels) == 0 {
+		return nil, nil
+	}
+
+	purged := make([]string, len(taskModels))
+	for i := range taskModels {
+		purged[i] = taskModels[i].ID
+	}
+
+	slices.Sort(purged)
+
+	entries := make([]domain.AuditEntry, len(purged))
+	for i, id := range purged {
+		entries[i] = domain.NewPurgeAuditEntry(domain.TaskID(id), stamp)
+	}
+
+	err = appendAudit(tx, entries...)
+	if err != nil {
+		return nil, err
+	}
+
+	return purged, orphanTasks(tx, purged, stamp)
+}
+
+// orphanTasks 상위 Task가 ids 중 하나인 Task를 최상위 Task로 만듭니다. 휴지통에 있는 Task는 상위 Task만 바꾸고,
+// 휴지통에 있지 않은 Task는 touchTasks로 버전을 올립니다.
+func orphanTasks(tx *gorm.DB, ids []string, stamp domain.Stamp) error {
+	var taskModels []TaskModel
+
+	err := tx.Preload("Tags").Where("parent_id IN ?", ids).Order("id").Find(&taskModels).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Unscoped().
+		Model(&TaskModel{}). //nolint:exhaustruct
+		Where("parent_id IN ?", ids).
+		Update("parent_id", "").Error
+	if err != nil {
+		return err
+	}
+
+	tasks := make([]*domain.Task, len(taskModels))
+	for i := range taskModels {
+		tasks[i] = toDomainTask(&taskModels[i])
+	}
+
+	return touchTasks(tx, tasks, stamp)
+}